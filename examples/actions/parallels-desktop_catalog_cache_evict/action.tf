@@ -0,0 +1,26 @@
+action "parallels-desktop_catalog_cache_evict" "example" {
+  config {
+    # You can only use one of the following options
+
+    # Use the host if you need to connect directly to a host
+    host = "https://example.com:8080"
+    # Use the orchestrator to evict from all the orchestrator hosts
+    orchestrator = "https://orchestrator.example.com:443"
+
+    # The authenticator block for authenticating to the API, either to the host or orchestrator
+    authenticator {
+      api_key = "host api key"
+    }
+
+    # Only evict from these orchestrator hosts
+    host_ids = ["host-1"]
+
+    # The catalog and version to evict, leave the version empty to evict all the versions
+    # and the catalog id empty to clear the whole cache
+    catalog_id = "ubuntu-golden"
+    version    = "1.4.0"
+  }
+}
+
+# The action can be invoked directly with
+# terraform apply -invoke=action.parallels-desktop_catalog_cache_evict.example
@@ -0,0 +1,14 @@
+terraform {
+  # actions are only supported from Terraform 1.14
+  required_version = ">= 1.14.0"
+  required_providers {
+    parallels-desktop = {
+      source = "parallels/parallels-desktop"
+    }
+  }
+}
+
+provider "parallels-desktop" {
+  license                = "YOUR_PARALLELS_DESKTOP_LICENSE_KEY"
+  disable_tls_validation = true
+}
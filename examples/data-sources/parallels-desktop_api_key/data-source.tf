@@ -0,0 +1,14 @@
+data "parallels-desktop_api_key" "ci" {
+  host = "https://mac-01.example.com:8080"
+
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # Look up the api key by its id or its name, the secret is never returned
+  name = "ci"
+}
+
+output "ci_api_key_revoked" {
+  value = data.parallels-desktop_api_key.ci.revoked
+}
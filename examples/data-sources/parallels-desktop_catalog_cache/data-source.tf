@@ -0,0 +1,26 @@
+data "parallels-desktop_catalog_cache" "example" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "https://example.com:8080"
+  # Use the orchestrator to list the cache of all the orchestrator hosts
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # Only list the cache of these orchestrator hosts
+  host_ids = ["host-1"]
+  # Only list the cached images of this catalog
+  catalog_id = "ubuntu-golden"
+}
+
+output "cached_images" {
+  value = [for item in data.parallels-desktop_catalog_cache.example.items : "${item.catalog_id}:${item.version} ${item.size} last used ${item.last_used_at}"]
+}
+
+output "cache_size" {
+  value = data.parallels-desktop_catalog_cache.example.total_size
+}
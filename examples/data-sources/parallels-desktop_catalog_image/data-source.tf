@@ -0,0 +1,25 @@
+data "parallels-desktop_catalog_image" "example" {
+  # The connection to the catalog provider
+  catalog_connection = "host=user:VerySecretPassword@example.com"
+
+  # The catalog id of the image
+  catalog_id = "example-catalog-id"
+  # The version of the image, it can be an exact version, latest
+  # or a version constraint like "~> 1.2", defaults to latest
+  version = "~> 1.2"
+  # The architecture of the image
+  architecture = "arm64"
+  # The image needs to contain all of these tags
+  tags = ["golden"]
+}
+
+# The image can then be used to create a machine
+resource "parallels-desktop_remote_vm" "example" {
+  host               = "https://example.com:8080"
+  name               = "example-vm"
+  catalog_id         = data.parallels-desktop_catalog_image.example.catalog_id
+  version            = data.parallels-desktop_catalog_image.example.resolved_version
+  architecture       = data.parallels-desktop_catalog_image.example.architecture
+  catalog_connection = "host=user:VerySecretPassword@example.com"
+  path               = "/Users/example/Parallels"
+}
@@ -0,0 +1,17 @@
+data "parallels-desktop_catalog_images" "example" {
+  # The connection to the catalog provider, special characters in the credentials need to be
+  # url escaped and parameters can be used for catalogs behind a custom prefix or certificate
+  catalog_connection = "host=api_key:my%40key@catalog.example.com?port=8443&api_prefix=/devops/api&tls_skip_verify=true"
+
+  # All of the following filters are optional
+
+  # Only list the images of this catalog id
+  catalog_id = "example-catalog-id"
+  # Only list the images matching this version, it can be an exact
+  # version, latest or a version constraint like "~> 1.2"
+  version = ">= 1.0, < 2.0"
+  # Only list the images for this architecture
+  architecture = "arm64"
+  # Only list the images containing all of these tags
+  tags = ["ubuntu", "golden"]
+}
@@ -0,0 +1,14 @@
+data "parallels-desktop_claim" "example" {
+  host = "https://mac-01.example.com:8080"
+
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # Look up the claim by its id or its name
+  name = "CI_RUNNER"
+}
+
+output "claim_id" {
+  value = data.parallels-desktop_claim.example.id
+}
@@ -0,0 +1,20 @@
+data "parallels-desktop_orchestrator_hosts" "example" {
+  orchestrator = "https://orchestrator.example.com:443"
+
+  authenticator {
+    api_key = "orchestrator.api_key"
+  }
+
+  # All of the following filters are optional
+
+  # Only list the hosts for this architecture
+  architecture = "arm64"
+  # Only list the hosts in this state
+  state = "healthy"
+  # Only list the hosts containing all of these tags
+  tags = ["ci", "macos"]
+}
+
+output "available_hosts" {
+  value = [for host in data.parallels-desktop_orchestrator_hosts.example.hosts : host.host if host.enabled]
+}
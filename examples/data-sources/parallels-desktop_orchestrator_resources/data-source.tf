@@ -0,0 +1,18 @@
+data "parallels-desktop_orchestrator_resources" "example" {
+  orchestrator = "https://orchestrator.example.com:443"
+
+  authenticator {
+    api_key = "orchestrator.api_key"
+  }
+
+  # All of the following filters are optional
+
+  # Only report the resources of this architecture
+  architecture = "arm64"
+  # Only report the resources of these hosts
+  host_ids = ["host-id-1", "host-id-2"]
+}
+
+output "available_memory" {
+  value = data.parallels-desktop_orchestrator_resources.example.total_available.memory_size
+}
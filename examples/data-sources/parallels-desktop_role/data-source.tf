@@ -0,0 +1,14 @@
+data "parallels-desktop_role" "example" {
+  host = "https://mac-01.example.com:8080"
+
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # Look up the role by its id or its name
+  name = "DEVELOPER"
+}
+
+output "role_id" {
+  value = data.parallels-desktop_role.example.id
+}
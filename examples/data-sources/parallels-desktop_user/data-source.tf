@@ -0,0 +1,14 @@
+data "parallels-desktop_user" "ci_runner" {
+  host = "https://mac-01.example.com:8080"
+
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # Look up the user by its id or its username
+  username = "ci-runner"
+}
+
+output "ci_runner_roles" {
+  value = data.parallels-desktop_user.ci_runner.roles
+}
@@ -3,4 +3,8 @@ provider "parallels-desktop" {
   # Optional, will disable TLS validation when doing calls to the API using HTTPS
   # this is useful when the API is using a self-signed certificate
   disable_tls_validation = true
+  # Optional, the API prefix used by the hosts, this is useful when the API is behind
+  # a path based gateway. A host can also set its own prefix in its url, for example
+  # host = "https://gateway.example.com/devops/api"
+  api_prefix = "/devops/api"
 }
@@ -0,0 +1,4 @@
+# Api keys can be imported by id or name, credentials can optionally be passed in front of the host.
+# The secret cannot be read back and is taken from the configuration on the next apply.
+# Keys whose creation time the host does not return count their rotation and expiry from the import
+terraform import parallels-desktop_api_key.ci "api_key:my_api_key@https://mac-01.example.com:8080@ci"
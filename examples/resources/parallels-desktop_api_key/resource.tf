@@ -0,0 +1,27 @@
+resource "parallels-desktop_api_key" "ci" {
+  host = "https://mac-01.example.com:8080"
+
+  # The authenticator block for authenticating to the host API
+  authenticator {
+    api_key = "host api key"
+  }
+
+  name = "ci"
+  key  = "ci"
+
+  # The secret is generated, a replacement key is created every 60 days and
+  # the previous one is kept for 7 days so clients can pick up the new one
+  expiry_days   = 90
+  rotation_days = 60
+  overlap_days  = 7
+}
+
+output "ci_api_key" {
+  value     = parallels-desktop_api_key.ci.api_key
+  sensitive = true
+}
+
+output "ci_previous_api_key" {
+  value     = parallels-desktop_api_key.ci.previous_api_key
+  sensitive = true
+}
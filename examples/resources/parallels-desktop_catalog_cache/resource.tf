@@ -0,0 +1,46 @@
+resource "parallels-desktop_catalog_cache" "example" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "https://example.com:8080"
+  # Use the orchestrator to warm all the orchestrator hosts
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # The connection to the catalog provider
+  catalog_connection = "host=user:VerySecretPassword@example.com"
+  # The catalog id and version to cache
+  catalog_id = "ubuntu-golden"
+  version    = "1.4.0"
+  # When using an orchestrator only the hosts with this architecture are warmed
+  architecture = "arm64"
+
+  # Only warm these orchestrator hosts, if not set all the enabled hosts are warmed
+  host_ids = ["host-1", "host-2"]
+
+  # Remove the image from the hosts cache when this resource is destroyed
+  evict_on_destroy = true
+
+  timeouts = {
+    create = "2h"
+  }
+}
+
+# Machines created after the cache is warm will not need to download the image
+resource "parallels-desktop_remote_vm" "example" {
+  count = 30
+
+  orchestrator = "https://orchestrator.example.com:443"
+  authenticator {
+    api_key = "host api key"
+  }
+
+  name               = "student-${count.index}"
+  catalog_connection = "host=user:VerySecretPassword@example.com"
+  catalog_id         = parallels-desktop_catalog_cache.example.catalog_id
+  version            = parallels-desktop_catalog_cache.example.version
+}
@@ -0,0 +1,37 @@
+resource "parallels-desktop_catalog_push" "example" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "https://example.com:8080"
+  # Use the orchestrator if you need to connect to a Parallels Orchestrator
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "host api key"
+  }
+
+  # The id of the VM to push, it will be stopped while it is pushed
+  vm_id = parallels-desktop_clone_vm.example.id
+
+  # The connection to the catalog provider
+  catalog_connection = "host=user:VerySecretPassword@example.com"
+  # The catalog id and version to publish
+  catalog_id = "ubuntu-golden"
+  version    = "1.4.0"
+  # The architecture of the catalog, defaults to the host architecture
+  architecture = "arm64"
+  description  = "Ubuntu golden image"
+  tags         = ["ubuntu", "golden"]
+
+  # Only users with these roles and claims will be able to pull the image
+  required_roles  = ["developers"]
+  required_claims = ["READ_ONLY"]
+
+  # Remove the version from the catalog when this resource is destroyed
+  delete_on_destroy = true
+
+  timeouts = {
+    create = "2h"
+  }
+}
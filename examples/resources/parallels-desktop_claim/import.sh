@@ -0,0 +1,2 @@
+# Claims can be imported by id or name, credentials can optionally be passed in front of the host
+terraform import parallels-desktop_claim.ci_runner "api_key:my_api_key@https://mac-01.example.com:8080@CI_RUNNER"
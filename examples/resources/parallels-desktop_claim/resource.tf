@@ -0,0 +1,10 @@
+resource "parallels-desktop_claim" "ci_runner" {
+  host = "https://mac-01.example.com:8080"
+
+  # The authenticator block for authenticating to the host API
+  authenticator {
+    api_key = "host api key"
+  }
+
+  name = "CI_RUNNER"
+}
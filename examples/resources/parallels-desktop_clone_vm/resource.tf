@@ -26,6 +26,15 @@ resource "parallels-desktop_clone_vm" "example" {
   base_vm_id = data.parallels-desktop_vm.example.machines[count.index].id
   path       = "/some/folder/path"
 
+  # Alternatively clone from a template, replacing the template will also replace the clone
+  # base_template_id = parallels-desktop_vm_template.example.id
+
+  # Create a linked clone, this will share the disk with the base VM
+  # and will be created in seconds using minimal disk space
+  linked = true
+  # The name or id of the base VM snapshot to clone from, this requires a linked clone
+  base_snapshot = "clean-state"
+
   # The authenticator block for authenticating to the API, either to the host or orchestrator
   # in this case we are using the API key
   authenticator {
@@ -0,0 +1,29 @@
+resource "parallels-desktop_host_drain" "mac_01" {
+  # The orchestrator the host is registered in
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the orchestrator API
+  authenticator {
+    api_key = "orchestrator api key"
+  }
+
+  # The host to take out of service, it stays in maintenance until this resource is destroyed.
+  # The host cannot be managed by a parallels-desktop_orchestrator_host resource at the same time
+  host_id = "orchestrator host id"
+
+  # What to do with the machines in the host, none, stop or migrate
+  vm_action = "migrate"
+  # The catalog used to move the machines to other hosts
+  catalog_connection = "host=user:VerySecretPassword@catalog.example.com"
+
+  # Wait until there are no running machines in the host
+  wait_until_empty = true
+
+  timeouts = {
+    create = "4h"
+  }
+}
+
+output "moved_vms" {
+  value = parallels-desktop_host_drain.mac_01.moved_vms
+}
@@ -0,0 +1,28 @@
+resource "parallels-desktop_orchestrator_host" "mac_01" {
+  # The orchestrator the host will be registered in
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the orchestrator API
+  authenticator {
+    api_key = "orchestrator api key"
+  }
+
+  # The address of the Parallels Desktop DevOps host, the host only needs to be
+  # reachable by the orchestrator so it can be provisioned by any means
+  host = "https://mac-01.example.com:8080"
+
+  # The credentials the orchestrator uses to connect to the host
+  host_credentials {
+    api_key = "host api key"
+  }
+
+  description     = "Build machine 01"
+  tags            = ["ci", "macos"]
+  required_roles  = ["ci-admin"]
+  required_claims = ["CREATE_VM"]
+
+  # Set maintenance to true to stop the orchestrator from placing new machines
+  # on the host while keeping the running ones
+  enabled     = true
+  maintenance = false
+}
@@ -12,8 +12,12 @@ resource "parallels-desktop_remote_vm" "example_box" {
   owner = "example"
   # The catalog id of the VM from the catalog provider
   catalog_id = "example-catalog-id"
-  # The version of the VM from the catalog provider
+  # The version of the VM from the catalog provider, this can also be
+  # latest or a version constraint like "~> 1.2" or ">= 2.0, < 3.0"
   version = "v1"
+  # Use on_new_version to replace the VM when a new version matching
+  # the version constraint is published, pin will keep the current one
+  upgrade_policy = "pin"
   # The connection to the catalog provider
   catalog_connection = "host=user:VerySecretPassword@example.com"
   # The path where the VM will be stored
@@ -26,6 +30,19 @@ resource "parallels-desktop_remote_vm" "example_box" {
     api_key = "host api key"
   }
 
+  # When using an orchestrator the placement block chooses the host the VM is created on,
+  # it is only used when the VM is created and does not move existing VMs
+  placement {
+    # The host needs to have all of these tags, and hosts with the preferred tags are chosen first
+    required_tags  = ["macos", "ci"]
+    preferred_tags = ["m2"]
+    # VMs in the same anti affinity group are spread across different hosts
+    anti_affinity_group = "build-agents"
+    # The host needs to have at least these free resources, memory and disk are in megabytes
+    min_free_cpu_count   = 4
+    min_free_memory_size = 8192
+  }
+
   # This will contain some common configuration for the VM
   # like if we should start it headless or not
   config {
@@ -0,0 +1,102 @@
+resource "parallels-desktop_reverse_proxy_host" "web" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "https://example.com:8080"
+  # Use the orchestrator and the host id if the reverse proxy runs in one of the orchestrator hosts
+  orchestrator = "https://orchestrator.example.com:443"
+  host_id      = "orchestrator-host-id"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "some api key"
+  }
+
+  # The address and port the reverse proxy listens on
+  proxy_host = "0.0.0.0"
+  port       = "80"
+
+  cors {
+    enabled         = true
+    allowed_origins = ["https://example.com"]
+    allowed_methods = ["GET", "POST"]
+  }
+
+  # Routes can target a machine by id, the reverse proxy resolves its internal address
+  http_routes {
+    path         = "/api"
+    target_vm_id = parallels-desktop_vm.api.id
+    target_port  = "8080"
+    schema       = "http"
+  }
+
+  http_routes {
+    path         = "/"
+    target_vm_id = parallels-desktop_clone_vm.web.id
+    target_port  = "80"
+    schema       = "http"
+    request_headers = {
+      "X-Forwarded-Proto" = "https"
+    }
+  }
+}
+
+resource "parallels-desktop_reverse_proxy_host" "ssh" {
+  host = "https://example.com:8080"
+
+  authenticator {
+    api_key = "some api key"
+  }
+
+  port = "2222"
+
+  # A host can forward raw tcp traffic instead of routing http requests
+  tcp_route {
+    target_vm_id = parallels-desktop_vm.api.id
+    target_port  = "22"
+  }
+}
+
+resource "parallels-desktop_reverse_proxy_host" "blue_green" {
+  host = "https://example.com:8080"
+
+  authenticator {
+    api_key = "some api key"
+  }
+
+  port = "8443"
+
+  # Balance a route between a pool of machines, drain the old ones before removing them
+  http_routes {
+    path           = "/"
+    schema         = "http"
+    target_port    = "80"
+    load_balancing = "least_connections"
+
+    targets {
+      vm_id  = parallels-desktop_clone_vm.blue.id
+      weight = 1
+      drain  = true
+    }
+
+    targets {
+      vm_id  = parallels-desktop_clone_vm.green.id
+      weight = 3
+    }
+
+    targets {
+      host = "10.0.0.20"
+      port = "8080"
+    }
+
+    health_check {
+      type                = "http"
+      path                = "/health"
+      expected_status     = 200
+      interval            = 10
+      timeout             = 2
+      healthy_threshold   = 2
+      unhealthy_threshold = 3
+    }
+  }
+}
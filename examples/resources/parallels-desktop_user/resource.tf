@@ -0,0 +1,31 @@
+resource "random_password" "ci_runner" {
+  length  = 32
+  special = false
+}
+
+resource "parallels-desktop_user" "ci_runner" {
+  host = "https://mac-01.example.com:8080"
+
+  # The authenticator block for authenticating to the host API
+  authenticator {
+    api_key = "host api key"
+  }
+
+  username = "ci-runner"
+  name     = "CI Runner"
+  email    = "ci-runner@example.com"
+  password = random_password.ci_runner.result
+}
+
+# Roles are given to the user in their own resource so they can be owned by
+# another workspace
+resource "parallels-desktop_user_role_attachment" "ci_runner_developer" {
+  host = "https://mac-01.example.com:8080"
+
+  authenticator {
+    api_key = "host api key"
+  }
+
+  user_id = parallels-desktop_user.ci_runner.id
+  role    = "DEVELOPER"
+}
@@ -0,0 +1,20 @@
+data "parallels-desktop_user" "ci_runner" {
+  host     = "https://mac-01.example.com:8080"
+  username = "ci-runner"
+
+  authenticator {
+    api_key = "host api key"
+  }
+}
+
+resource "parallels-desktop_user_role_attachment" "ci_runner_developer" {
+  host = "https://mac-01.example.com:8080"
+
+  # The authenticator block for authenticating to the host API
+  authenticator {
+    api_key = "host api key"
+  }
+
+  user_id = data.parallels-desktop_user.ci_runner.id
+  role    = "DEVELOPER"
+}
@@ -0,0 +1,75 @@
+resource "parallels-desktop_vm" "ubuntu" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "http://example.com:8080"
+  # Use the orchestrator if you need to connect to a Parallels Orchestrator
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "some api key"
+  }
+
+  # Optional, reach an API that is only listening on the host loopback address
+  # through a ssh tunnel, the tunnel is closed once the operation is done
+  ssh_tunnel {
+    user        = "example"
+    private_key = file("~/.ssh/id_ed25519")
+  }
+
+  name         = "ubuntu-base"
+  os_type      = "linux"
+  distribution = "ubuntu"
+
+  # The installation ISO in the host, remove it once the installation is done
+  # to detach the installation media from the VM
+  iso_path   = "/Users/example/Downloads/ubuntu-24.04-live-server-arm64.iso"
+  boot_order = ["cdrom0", "hdd0"]
+
+  specs {
+    cpu_count   = "4"
+    memory_size = "4096"
+  }
+
+  # Additional disks, changing the disks will recreate the VM
+  disk {
+    size      = "20480"
+    interface = "nvme"
+  }
+
+  # The first block configures the default adapter, any other block adds a new one
+  network_adapter {
+    type = "shared"
+  }
+
+  network_adapter {
+    type      = "bridged"
+    interface = "en0"
+  }
+
+  config {
+    start_headless = true
+  }
+
+  # Start the VM after creation so it boots into the installer
+  keep_running = true
+}
+
+resource "parallels-desktop_vm" "macos" {
+  host = "http://example.com:8080"
+
+  authenticator {
+    api_key = "some api key"
+  }
+
+  name = "macos-base"
+
+  # macOS VMs are installed from an IPSW restore image, only on Apple Silicon hosts
+  restore_image = "/Users/example/Downloads/UniversalMac_15.0_Restore.ipsw"
+
+  specs {
+    cpu_count   = "4"
+    memory_size = "8192"
+  }
+}
@@ -0,0 +1,29 @@
+resource "parallels-desktop_vm_snapshot" "clean" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "http://example.com:8080"
+  # Use the orchestrator if you need to connect to a Parallels Orchestrator
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "some api key"
+  }
+
+  # The id of the VM to snapshot
+  vm_id       = parallels-desktop_clone_vm.example.id
+  name        = "clean-state"
+  description = "Clean state before running the test suite"
+
+  # If a snapshot with the same name already exists it will be used
+  # and the VM will be reverted to it instead of creating a new one
+  revert_on_create = true
+
+  # Any change to this value will revert the VM to this snapshot
+  # for example, use the pipeline run id to reset the VM on every run
+  revert = var.pipeline_run_id
+
+  # This will also delete any snapshot taken after this one when destroying it
+  delete_children = true
+}
@@ -0,0 +1,53 @@
+resource "parallels-desktop_vm_template" "golden" {
+  # You can only use one of the following options
+
+  # Use the host if you need to connect directly to a host
+  host = "http://example.com:8080"
+  # Use the orchestrator if you need to connect to a Parallels Orchestrator
+  orchestrator = "https://orchestrator.example.com:443"
+
+  # The authenticator block for authenticating to the API, either to the host or orchestrator
+  authenticator {
+    api_key = "some api key"
+  }
+
+  # The id of the prepared VM to convert into a template
+  vm_id = parallels-desktop_clone_vm.prepared.id
+  name  = "golden-image"
+
+  # By default the template is converted back into a VM when destroyed
+  # set this to true to delete it instead
+  delete_on_destroy = false
+}
+
+resource "parallels-desktop_vm_template" "from_catalog" {
+  host = "http://example.com:8080"
+
+  authenticator {
+    api_key = "some api key"
+  }
+
+  # Import the template from a catalog instead of converting an existing VM
+  catalog_id         = "ubuntu-golden"
+  version            = "v1"
+  architecture       = "arm64"
+  catalog_connection = "host=user:password@catalog.example.com"
+  path               = "/Users/example/Parallels"
+  name               = "ubuntu-golden"
+}
+
+resource "parallels-desktop_clone_vm" "worker" {
+  count = 3
+
+  host = "http://example.com:8080"
+
+  authenticator {
+    api_key = "some api key"
+  }
+
+  name = "worker-${count.index}"
+  path = "/Users/example/Parallels"
+
+  # Replacing the template will also replace all the clones based on it
+  base_template_id = parallels-desktop_vm_template.golden.id
+}
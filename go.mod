@@ -5,6 +5,7 @@ go 1.25.0
 require (
 	github.com/amplitude/analytics-go v1.3.1
 	github.com/cjlapao/common-go v0.0.49
+	github.com/hashicorp/go-version v1.7.0
 	github.com/hashicorp/terraform-plugin-framework v1.19.0
 	github.com/hashicorp/terraform-plugin-framework-timeouts v0.7.0
 	github.com/hashicorp/terraform-plugin-framework-validators v0.19.0
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,20 +19,20 @@ func AddRoleToUser(ctx context.Context, config HostConfig, userId string, role s
 	}
 
 	tflog.Info(ctx, "Adding Role "+role+" to User "+userId)
-	urlHost := helpers.GetHostUrl(config.Host)
-	url := fmt.Sprintf("%s/auth/users/%s/roles", helpers.GetHostApiVersionedBaseUrl(urlHost), userId)
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
+	url := fmt.Sprintf("%s/auth/users/%s/roles", config.GetApiBaseUrl(), userId)
+
 	request := apimodels.AddUserClaimRoleRequest{
 		Name: role,
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.PostDataToClient(ctx, url, nil, request, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error adding role to user: %v, api message: %s", err, clientResponse.ApiError.Message))
@@ -0,0 +1,6 @@
+package apimodels
+
+type ApiVersionResponse struct {
+	Version     string   `json:"version"`
+	ApiVersions []string `json:"api_versions,omitempty"`
+}
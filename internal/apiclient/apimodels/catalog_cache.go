@@ -0,0 +1,24 @@
+package apimodels
+
+type CatalogCacheResponse struct {
+	TotalSize int64              `json:"total_size"`
+	Manifests []CatalogCacheItem `json:"manifests"`
+}
+
+type CatalogCacheItem struct {
+	ID            string `json:"id"`
+	CatalogId     string `json:"catalog_id"`
+	Version       string `json:"version"`
+	Architecture  string `json:"architecture"`
+	CacheType     string `json:"cache_type,omitempty"`
+	CacheSize     int64  `json:"cache_size"`
+	CacheDate     string `json:"cache_date,omitempty"`
+	CacheLastUsed string `json:"cache_last_used,omitempty"`
+}
+
+type CacheCatalogRequest struct {
+	CatalogId    string `json:"catalog_id"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture,omitempty"`
+	Connection   string `json:"connection"`
+}
@@ -22,6 +22,7 @@ type CatalogManifest struct {
 	LastDownloadedUser string                       `json:"last_downloaded_user"`
 	DownloadCount      int64                        `json:"download_count"`
 	PackContents       []CatalogManifestPackContent `json:"pack_contents"`
+	Size               int64                        `json:"size"`
 }
 
 type CatalogManifestPackContent struct {
@@ -0,0 +1,10 @@
+package apimodels
+
+type CreateNewVmRequest struct {
+	Name         string `json:"name"`
+	Owner        string `json:"owner,omitempty"`
+	OsType       string `json:"os_type,omitempty"`
+	Distribution string `json:"distribution,omitempty"`
+	RestoreImage string `json:"restore_image,omitempty"`
+	Path         string `json:"path,omitempty"`
+}
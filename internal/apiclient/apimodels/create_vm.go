@@ -7,6 +7,22 @@ type CreateVmRequest struct {
 	PackerTemplate  *CreatePackerVmRequest        `json:"packer_template,omitempty"`
 	VagrantBox      *CreateVagrantVmRequest       `json:"vagrant_box,omitempty"`
 	CatalogManifest *CreateCatalogManifestRequest `json:"catalog_manifest,omitempty"`
+	NewVm           *CreateNewVmRequest           `json:"new_vm,omitempty"`
+	Placement       *CreateVmPlacementRequest     `json:"placement,omitempty"`
+}
+
+type CreateVmPlacementRequest struct {
+	HostId            string                      `json:"host_id,omitempty"`
+	RequiredTags      []string                    `json:"required_tags,omitempty"`
+	PreferredTags     []string                    `json:"preferred_tags,omitempty"`
+	AntiAffinityGroup string                      `json:"anti_affinity_group,omitempty"`
+	MinFreeResources  *CreateVmPlacementResources `json:"min_free_resources,omitempty"`
+}
+
+type CreateVmPlacementResources struct {
+	LogicalCpuCount int64   `json:"logical_cpu_count,omitempty"`
+	MemorySize      float64 `json:"memory_size,omitempty"`
+	DiskSize        float64 `json:"disk_size,omitempty"`
 }
 
 type CreateVmResponse struct {
@@ -41,6 +41,7 @@ type OrchestratorHost struct {
 	ParallelsDesktopVersion  string                    `json:"parallels_desktop_version,omitempty"`
 	ParallelsDesktopLicensed bool                      `json:"parallels_desktop_licensed,omitempty"`
 	IsReverseProxyEnabled    bool                      `json:"is_reverse_proxy_enabled"`
+	Maintenance              bool                      `json:"maintenance,omitempty"`
 	ReverseProxy             *HostReverseProxy         `json:"reverse_proxy,omitempty"`
 	Resources                OrchestratorHostResources `json:"resources"`
 	RequiredClaims           []string                  `json:"required_claims,omitempty"`
@@ -0,0 +1,13 @@
+package apimodels
+
+type PushCatalogRequest struct {
+	CatalogId      string   `json:"catalog_id,omitempty"`
+	Version        string   `json:"version,omitempty"`
+	Architecture   string   `json:"architecture,omitempty"`
+	Connection     string   `json:"connection,omitempty"`
+	LocalPath      string   `json:"local_path,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	RequiredRoles  []string `json:"required_roles,omitempty"`
+	RequiredClaims []string `json:"required_claims,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
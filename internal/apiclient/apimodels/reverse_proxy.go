@@ -17,10 +17,13 @@ type ReverseProxyHostTls struct {
 }
 
 type ReverseProxyHostTcpRoute struct {
-	ID         string `json:"id,omitempty" yaml:"id,omitempty"`
-	TargetPort string `json:"target_port,omitempty" yaml:"target_port,omitempty"`
-	TargetHost string `json:"target_host,omitempty" yaml:"target_host,omitempty"`
-	TargetVmId string `json:"target_vm_id,omitempty" yaml:"target_vm_id,omitempty"`
+	ID            string                   `json:"id,omitempty" yaml:"id,omitempty"`
+	TargetPort    string                   `json:"target_port,omitempty" yaml:"target_port,omitempty"`
+	TargetHost    string                   `json:"target_host,omitempty" yaml:"target_host,omitempty"`
+	TargetVmId    string                   `json:"target_vm_id,omitempty" yaml:"target_vm_id,omitempty"`
+	Targets       []*ReverseProxyTarget    `json:"targets,omitempty" yaml:"targets,omitempty"`
+	LoadBalancing string                   `json:"load_balancing,omitempty" yaml:"load_balancing,omitempty"`
+	HealthCheck   *ReverseProxyHealthCheck `json:"health_check,omitempty" yaml:"health_check,omitempty"`
 }
 
 type ReverseProxyHostCors struct {
@@ -31,13 +34,38 @@ type ReverseProxyHostCors struct {
 }
 
 type ReverseProxyHostHttpRoute struct {
-	ID              string            `json:"id,omitempty" yaml:"id,omitempty"`
-	Path            string            `json:"path,omitempty" yaml:"path,omitempty"`
-	TargetVmId      string            `json:"target_vm_id,omitempty" yaml:"target_vm_id,omitempty"`
-	TargetHost      string            `json:"target_host,omitempty" yaml:"target_host,omitempty"`
-	TargetPort      string            `json:"target_port,omitempty" yaml:"target_port,omitempty"`
-	Schema          string            `json:"schema,omitempty" yaml:"scheme,omitempty"`
-	Pattern         string            `json:"pattern,omitempty" yaml:"pattern,omitempty"`
-	RequestHeaders  map[string]string `json:"request_headers,omitempty" yaml:"request_headers,omitempty"`
-	ResponseHeaders map[string]string `json:"response_headers,omitempty" yaml:"response_headers,omitempty"`
+	ID              string                   `json:"id,omitempty" yaml:"id,omitempty"`
+	Path            string                   `json:"path,omitempty" yaml:"path,omitempty"`
+	TargetVmId      string                   `json:"target_vm_id,omitempty" yaml:"target_vm_id,omitempty"`
+	TargetHost      string                   `json:"target_host,omitempty" yaml:"target_host,omitempty"`
+	TargetPort      string                   `json:"target_port,omitempty" yaml:"target_port,omitempty"`
+	Schema          string                   `json:"schema,omitempty" yaml:"scheme,omitempty"`
+	Pattern         string                   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	RequestHeaders  map[string]string        `json:"request_headers,omitempty" yaml:"request_headers,omitempty"`
+	ResponseHeaders map[string]string        `json:"response_headers,omitempty" yaml:"response_headers,omitempty"`
+	Targets         []*ReverseProxyTarget    `json:"targets,omitempty" yaml:"targets,omitempty"`
+	LoadBalancing   string                   `json:"load_balancing,omitempty" yaml:"load_balancing,omitempty"`
+	HealthCheck     *ReverseProxyHealthCheck `json:"health_check,omitempty" yaml:"health_check,omitempty"`
+}
+
+// ReverseProxyTarget is one of the targets a route balances the traffic between, a target
+// can be a machine or a host address
+type ReverseProxyTarget struct {
+	VmId   string `json:"vm_id,omitempty" yaml:"vm_id,omitempty"`
+	Host   string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port   string `json:"port,omitempty" yaml:"port,omitempty"`
+	Weight int64  `json:"weight,omitempty" yaml:"weight,omitempty"`
+	Drain  bool   `json:"drain,omitempty" yaml:"drain,omitempty"`
+}
+
+// ReverseProxyHealthCheck is the active health check of the route targets, the interval and
+// timeout are in seconds
+type ReverseProxyHealthCheck struct {
+	Type               string `json:"type,omitempty" yaml:"type,omitempty"`
+	Path               string `json:"path,omitempty" yaml:"path,omitempty"`
+	ExpectedStatus     int64  `json:"expected_status,omitempty" yaml:"expected_status,omitempty"`
+	Interval           int64  `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Timeout            int64  `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	HealthyThreshold   int64  `json:"healthy_threshold,omitempty" yaml:"healthy_threshold,omitempty"`
+	UnhealthyThreshold int64  `json:"unhealthy_threshold,omitempty" yaml:"unhealthy_threshold,omitempty"`
 }
@@ -1,5 +1,10 @@
 package apimodels
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 type VirtualMachine struct {
 	User                  string                             `json:"user"`
 	ID                    string                             `json:"ID"`
@@ -7,6 +12,7 @@ type VirtualMachine struct {
 	HostUrl               string                             `json:"host_url"`
 	HostId                string                             `json:"host_id"`
 	HostExternalIpAddress string                             `json:"host_external_ip_address"`
+	AntiAffinityGroup     string                             `json:"anti_affinity_group,omitempty"`
 	InternalIpAddress     string                             `json:"internal_ip_address"`
 	Name                  string                             `json:"Name"`
 	Description           string                             `json:"Description"`
@@ -38,7 +44,7 @@ type VirtualMachine struct {
 	ExternalBootDevice    string                             `json:"External boot device"`
 	SMBIOSSettings        VirtualMachineSMBIOSSettings       `json:"SMBIOS settings"`
 	Hardware              VirtualMachineHardware             `json:"Hardware"`
-	HostSharedFolders     VirtualMachineExpiration           `json:"Host Shared Folders"`
+	HostSharedFolders     VirtualMachineHostSharedFolders    `json:"Host Shared Folders"`
 	HostDefinedSharing    string                             `json:"Host defined sharing"`
 	SharedProfile         VirtualMachineExpiration           `json:"Shared Profile"`
 	SharedApplications    VirtualMachineSharedApplications   `json:"Shared Applications"`
@@ -53,6 +59,7 @@ type VirtualMachineAdvanced struct {
 	ShowDeveloperTools           string `json:"Show developer tools"`
 	SwipeFromEdges               string `json:"Swipe from edges"`
 	ShareHostLocation            string `json:"Share host location"`
+	RosettaLinux                 string `json:"Rosetta Linux"`
 }
 
 type VirtualMachineCoherence struct {
@@ -240,3 +247,46 @@ type VirtualMachineUSBAndBluetooth struct {
 	AutomaticSharingGamepads   string `json:"Automatic sharing gamepads"`
 	SupportUSB30               string `json:"Support USB 3.0"`
 }
+
+func (vm *VirtualMachine) IsTemplate() bool {
+	return strings.EqualFold(vm.Template, "yes")
+}
+
+type VirtualMachineHostSharedFolder struct {
+	Enabled     bool   `json:"enabled"`
+	Path        string `json:"path"`
+	Mode        string `json:"mode"`
+	Description string `json:"description"`
+}
+
+// VirtualMachineHostSharedFolders holds the shared folders, they are reported as keys next to the enabled flag
+type VirtualMachineHostSharedFolders struct {
+	Enabled bool                                      `json:"enabled"`
+	Folders map[string]VirtualMachineHostSharedFolder `json:"-"`
+}
+
+func (s *VirtualMachineHostSharedFolders) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	s.Folders = make(map[string]VirtualMachineHostSharedFolder)
+	for key, value := range raw {
+		if key == "enabled" {
+			if err := json.Unmarshal(value, &s.Enabled); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var folder VirtualMachineHostSharedFolder
+		if err := json.Unmarshal(value, &folder); err != nil {
+			// not a shared folder, ignoring it
+			continue
+		}
+		s.Folders[key] = folder
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package apimodels
+
+type VmSnapshot struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Date        string `json:"date"`
+	State       string `json:"state"`
+	Current     bool   `json:"current"`
+	Parent      string `json:"parent"`
+}
+
+type VmSnapshotListResponse struct {
+	Snapshots []VmSnapshot `json:"snapshots"`
+}
+
+func (r *VmSnapshotListResponse) GetById(id string) *VmSnapshot {
+	for i := range r.Snapshots {
+		if r.Snapshots[i].ID == id {
+			return &r.Snapshots[i]
+		}
+	}
+
+	return nil
+}
+
+func (r *VmSnapshotListResponse) GetCurrent() *VmSnapshot {
+	for i := range r.Snapshots {
+		if r.Snapshots[i].Current {
+			return &r.Snapshots[i]
+		}
+	}
+
+	return nil
+}
+
+// GetByName returns the snapshot with the given name, snapshot names are not unique so if there
+// is more than one the current one is preferred as it is the one that was created last
+func (r *VmSnapshotListResponse) GetByName(name string) *VmSnapshot {
+	var found *VmSnapshot
+	for i := range r.Snapshots {
+		if r.Snapshots[i].Name != name {
+			continue
+		}
+		if r.Snapshots[i].Current {
+			return &r.Snapshots[i]
+		}
+		found = &r.Snapshots[i]
+	}
+
+	return found
+}
+
+// GetByIdOrName returns the snapshot with the given id, or by name if there is none
+func (r *VmSnapshotListResponse) GetByIdOrName(idOrName string) *VmSnapshot {
+	if snapshot := r.GetById(idOrName); snapshot != nil {
+		return snapshot
+	}
+
+	return r.GetByName(idOrName)
+}
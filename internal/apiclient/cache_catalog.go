@@ -0,0 +1,38 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CacheCatalog downloads a catalog image into the host cache without creating a machine, when
+// using an orchestrator the HostId needs to be set to select the host
+func CacheCatalog(ctx context.Context, config HostConfig, request apimodels.CacheCatalogRequest) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostic.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostic
+	}
+
+	url := getCatalogCacheUrl(config)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.PutDataToClient(ctx, url, nil, request, auth, nil); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error caching catalog: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostic.AddError("There was an error caching the catalog "+request.CatalogId, err.Error())
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Cached catalog "+request.CatalogId+" version "+request.Version)
+
+	return diagnostic
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,9 +12,8 @@ import (
 
 func ConfigureMachine(ctx context.Context, config HostConfig, machineId string, configSet *apimodels.VmConfigRequest) (*apimodels.VmConfigResponse, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
@@ -24,13 +21,13 @@ func ConfigureMachine(ctx context.Context, config HostConfig, machineId string,
 
 	tflog.Debug(ctx, fmt.Sprintf("Configuring machine %v with configSet", *configSet))
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	var response apimodels.VmConfigResponse
 	var url string
 	if config.IsOrchestrator {
-		url = fmt.Sprintf("%s/orchestrator/machines/%s/set", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
+		url = fmt.Sprintf("%s/orchestrator/machines/%s/set", config.GetApiBaseUrl(), machineId)
 	} else {
-		url = fmt.Sprintf("%s/machines/%s/set", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
+		url = fmt.Sprintf("%s/machines/%s/set", config.GetApiBaseUrl(), machineId)
 	}
 
 	if clientResponse, err := client.PutDataToClient(ctx, url, nil, configSet, auth, &response); err != nil {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,16 +23,16 @@ func CreateClaim(ctx context.Context, config HostConfig, claimName string) (*api
 	}
 
 	tflog.Info(ctx, "Creating Claim "+request.Name)
-	urlHost := helpers.GetHostUrl(config.Host)
-	url := helpers.GetHostApiVersionedBaseUrl(urlHost) + "/auth/claims"
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := config.GetApiBaseUrl() + "/auth/claims"
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.PostDataToClient(ctx, url, nil, request, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error creating claim: %v, api message: %s", err, clientResponse.ApiError.Message))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,21 +15,21 @@ func CreateReverseProxyHost(ctx context.Context, config HostConfig, request apim
 	var response apimodels.ReverseProxyHost
 
 	tflog.Info(ctx, "Creating reverse proxy host "+request.Host+" with port "+request.Port)
-	urlHost := helpers.GetHostUrl(config.Host)
-	var url string
-	if config.IsOrchestrator {
-		url = fmt.Sprintf("%s/orchestrator/hosts/%s/reverse-proxy/hosts", helpers.GetHostApiVersionedBaseUrl(urlHost), config.HostId)
-	} else {
-		url = helpers.GetHostApiVersionedBaseUrl(urlHost) + "/reverse-proxy/hosts"
-	}
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	var url string
+	if config.IsOrchestrator {
+		url = fmt.Sprintf("%s/orchestrator/hosts/%s/reverse-proxy/hosts", config.GetApiBaseUrl(), config.HostId)
+	} else {
+		url = config.GetApiBaseUrl() + "/reverse-proxy/hosts"
+	}
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.PostDataToClient(ctx, url, nil, request, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error creating reverse proxy: %v, api message: %s", err, clientResponse.ApiError.Message))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,21 +12,21 @@ import (
 
 func CreateVm(ctx context.Context, config HostConfig, request apimodels.CreateVmRequest) (*apimodels.CreateVmResponse, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
-	var url string
-	if config.IsOrchestrator {
-		url = helpers.GetHostApiVersionedBaseUrl(urlHost) + "/orchestrator/machines"
-	} else {
-		url = helpers.GetHostApiVersionedBaseUrl(urlHost) + "/machines"
-	}
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	var url string
+	if config.IsOrchestrator {
+		url = config.GetApiBaseUrl() + "/orchestrator/machines"
+	} else {
+		url = config.GetApiBaseUrl() + "/machines"
+	}
+
+	client := config.NewHttpCaller(ctx)
 	var response apimodels.CreateVmResponse
 	if clientResponse, err := client.PostDataToClient(ctx, url, nil, request, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
@@ -0,0 +1,41 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func CreateVmSnapshot(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, name, description string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error creating the snapshot", "vm is nil")
+		return diagnostics
+	}
+	if name == "" {
+		diagnostics.AddError("There was an error creating the snapshot", "snapshot name is empty")
+		return diagnostics
+	}
+
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("snapshot")
+	op.WithOption("name", name)
+	if description != "" {
+		op.WithOption("description", description)
+	}
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Created snapshot "+name+" for machine "+vm.Name)
+
+	return diagnostics
+}
@@ -0,0 +1,43 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DeleteCatalogCache evicts a catalog image from the host cache, if the version is empty all the
+// versions of the catalog are evicted and if the catalog id is empty the whole cache is cleared
+func DeleteCatalogCache(ctx context.Context, config HostConfig, catalogId string, version string) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostic.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostic
+	}
+
+	url := getCatalogCacheUrl(config)
+	if catalogId != "" {
+		url = fmt.Sprintf("%s/%s", url, catalogId)
+		if version != "" {
+			url = fmt.Sprintf("%s/%s", url, version)
+		}
+	}
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.DeleteDataFromClient(ctx, url, nil, auth, nil); err != nil {
+		// nothing to evict
+		if clientResponse != nil && clientResponse.ApiError != nil && clientResponse.ApiError.Code == 404 {
+			return diagnostic
+		}
+		diagnostic.AddError("There was an error evicting the catalog cache", err.Error())
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Evicted catalog cache "+catalogId+" "+version)
+
+	return diagnostic
+}
@@ -0,0 +1,39 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func DeleteCatalogManifest(ctx context.Context, config HostConfig, catalogId string, version string, architecture string) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+	if catalogId == "" || version == "" || architecture == "" {
+		diagnostic.AddError("There was an error deleting the catalog manifest", "catalogId, version and architecture are required")
+		return diagnostic
+	}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostic.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostic
+	}
+
+	url := fmt.Sprintf("%s/catalog/%s/%s/%s", config.GetApiBaseUrl(), catalogId, version, architecture)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.DeleteDataFromClient(ctx, url, nil, auth, nil); err != nil {
+		// the manifest is already gone
+		if clientResponse != nil && clientResponse.ApiError != nil && clientResponse.ApiError.Code == 404 {
+			return diagnostic
+		}
+		diagnostic.AddError("There was an error deleting the catalog manifest", err.Error())
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Deleted catalog manifest "+catalogId+" version "+version+" "+architecture)
+
+	return diagnostic
+}
@@ -4,30 +4,26 @@ import (
 	"context"
 	"fmt"
 
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
-
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func DeleteClaim(ctx context.Context, config HostConfig, claimId string) diag.Diagnostics {
 	diagnostic := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
 	if claimId == "" {
 		diagnostic.AddError("There was an error deleting the claim", "claim id is empty")
 		return diagnostic
 	}
 
-	url := fmt.Sprintf("%s/auth/claims/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), claimId)
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostic.AddError("There was an error getting the authenticator", err.Error())
 		return diagnostic
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := fmt.Sprintf("%s/auth/claims/%s", config.GetApiBaseUrl(), claimId)
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.DeleteDataFromClient(ctx, url, nil, auth, nil); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
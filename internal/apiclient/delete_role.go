@@ -4,30 +4,26 @@ import (
 	"context"
 	"fmt"
 
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
-
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func DeleteRole(ctx context.Context, config HostConfig, roleId string) diag.Diagnostics {
 	diagnostic := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
 	if roleId == "" {
 		diagnostic.AddError("There was an error deleting the role", "role id is empty")
 		return diagnostic
 	}
 
-	url := fmt.Sprintf("%s/auth/roles/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), roleId)
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostic.AddError("There was an error getting the authenticator", err.Error())
 		return diagnostic
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := fmt.Sprintf("%s/auth/roles/%s", config.GetApiBaseUrl(), roleId)
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.DeleteDataFromClient(ctx, url, nil, auth, nil); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
@@ -0,0 +1,41 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func DeleteVmSnapshot(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, snapshotId string, deleteChildren bool) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error deleting the snapshot", "vm is nil")
+		return diagnostics
+	}
+	if snapshotId == "" {
+		diagnostics.AddError("There was an error deleting the snapshot", "snapshot id is empty")
+		return diagnostics
+	}
+
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("snapshot-delete")
+	op.WithOption("id", snapshotId)
+	if deleteChildren {
+		op.WithFlag("children")
+	}
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Deleted snapshot "+snapshotId+" from machine "+vm.Name)
+
+	return diagnostics
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,26 +12,26 @@ import (
 
 func ExecuteScript(ctx context.Context, config HostConfig, r apimodels.PostScriptItem) (*apimodels.VmExecuteCommandResponse, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
-	var url string
-	if config.IsOrchestrator {
-		url = fmt.Sprintf("%s/orchestrator/machines/%s/execute", helpers.GetHostApiVersionedBaseUrl(urlHost), r.VirtualMachineId)
-	} else {
-		url = fmt.Sprintf("%s/machines/%s/execute", helpers.GetHostApiVersionedBaseUrl(urlHost), r.VirtualMachineId)
-	}
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
+	var url string
+	if config.IsOrchestrator {
+		url = fmt.Sprintf("%s/orchestrator/machines/%s/execute", config.GetApiBaseUrl(), r.VirtualMachineId)
+	} else {
+		url = fmt.Sprintf("%s/machines/%s/execute", config.GetApiBaseUrl(), r.VirtualMachineId)
+	}
+
 	request := apimodels.VmExecuteCommandRequest{
 		Command:              r.Command,
 		EnvironmentVariables: r.EnvironmentVariables,
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	var response apimodels.VmExecuteCommandResponse
 	if clientResponse, err := client.PutDataToClient(ctx, url, nil, request, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
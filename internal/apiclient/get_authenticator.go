@@ -0,0 +1,18 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/helpers"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+)
+
+// getAuthenticator negotiates the api version with the host on the first contact and returns the
+// authentication to use with it
+func getAuthenticator(ctx context.Context, config HostConfig) (*helpers.HttpCallerAuth, error) {
+	if _, err := NegotiateApiVersion(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return authenticator.GetAuthenticator(ctx, config.NewHttpCaller(ctx), config.GetApiBaseUrl(), config.License, config.Authorization)
+}
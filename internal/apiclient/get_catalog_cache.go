@@ -0,0 +1,47 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetCatalogCache returns the catalog images cached in the host, when using an orchestrator
+// the HostId needs to be set to select the host
+func GetCatalogCache(ctx context.Context, config HostConfig) (*apimodels.CatalogCacheResponse, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	var response apimodels.CatalogCacheResponse
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return nil, diagnostics
+	}
+
+	url := getCatalogCacheUrl(config)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error getting catalog cache: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error getting the catalog cache", err.Error())
+		return nil, diagnostics
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Got %d cached catalog images", len(response.Manifests)))
+
+	return &response, diagnostics
+}
+
+func getCatalogCacheUrl(config HostConfig) string {
+	if config.IsOrchestrator {
+		return fmt.Sprintf("%s/orchestrator/hosts/%s/catalog/cache", config.GetApiBaseUrl(), config.HostId)
+	}
+
+	return config.GetApiBaseUrl() + "/catalog/cache"
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,7 +13,6 @@ import (
 func GetCatalogManifest(ctx context.Context, config HostConfig, catalogId string, version string, architecture string) (*apimodels.CatalogManifest, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	var response *apimodels.CatalogManifest
-	urlHost := helpers.GetHostUrl(config.Host)
 	if catalogId == "" {
 		diagnostics.AddError("There was an error getting the catalog manifest", "catalogId is empty")
 		return nil, diagnostics
@@ -28,15 +25,15 @@ func GetCatalogManifest(ctx context.Context, config HostConfig, catalogId string
 		architecture = "arm64"
 	}
 
-	url := fmt.Sprintf("%s/catalog/%s/%s/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), catalogId, version, architecture)
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := fmt.Sprintf("%s/catalog/%s/%s/%s", config.GetApiBaseUrl(), catalogId, version, architecture)
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
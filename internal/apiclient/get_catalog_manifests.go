@@ -0,0 +1,44 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func GetCatalogManifests(ctx context.Context, config HostConfig, catalogId string) ([]*apimodels.CatalogManifest, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	var response []*apimodels.CatalogManifest
+	if catalogId == "" {
+		diagnostics.AddError("There was an error getting the catalog manifests", "catalogId is empty")
+		return nil, diagnostics
+	}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return nil, diagnostics
+	}
+
+	url := fmt.Sprintf("%s/catalog/%s", config.GetApiBaseUrl(), catalogId)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			if clientResponse.ApiError.Code == 404 {
+				return nil, diagnostics
+			}
+			tflog.Error(ctx, fmt.Sprintf("Error getting catalog manifests: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error getting the catalog manifests", err.Error())
+		return nil, diagnostics
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Got %d catalog manifests for %s", len(response), catalogId))
+
+	return response, diagnostics
+}
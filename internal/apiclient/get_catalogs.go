@@ -0,0 +1,41 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetCatalogs returns all the catalog manifests in the catalog grouped by catalog id
+func GetCatalogs(ctx context.Context, config HostConfig) (map[string][]*apimodels.CatalogManifest, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	var response map[string][]*apimodels.CatalogManifest
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return nil, diagnostics
+	}
+
+	url := config.GetApiBaseUrl() + "/catalog"
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			if clientResponse.ApiError.Code == 404 {
+				return nil, diagnostics
+			}
+			tflog.Error(ctx, fmt.Sprintf("Error getting catalogs: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error getting the catalogs", err.Error())
+		return nil, diagnostics
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Got %d catalogs", len(response)))
+
+	return response, diagnostics
+}
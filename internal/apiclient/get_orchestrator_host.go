@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,21 +13,20 @@ import (
 func GetOrchestratorHost(ctx context.Context, config HostConfig, hostId string) (*apimodels.OrchestratorHost, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	var response apimodels.OrchestratorHost
-	urlHost := helpers.GetHostUrl(config.Host)
 	if hostId == "" {
 		diagnostics.AddError("There was an error getting the orchestrator host", "orchestratorHostId is empty")
 		return nil, diagnostics
 	}
 
-	url := fmt.Sprintf("%s/orchestrator/hosts/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), hostId)
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := fmt.Sprintf("%s/orchestrator/hosts/%s", config.GetApiBaseUrl(), hostId)
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
@@ -49,17 +46,16 @@ func GetOrchestratorHost(ctx context.Context, config HostConfig, hostId string)
 func GetOrchestratorHosts(ctx context.Context, config HostConfig) ([]apimodels.OrchestratorHost, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	var response []apimodels.OrchestratorHost
-	urlHost := helpers.GetHostUrl(config.Host)
 
-	url := helpers.GetHostApiVersionedBaseUrl(urlHost) + "/orchestrator/hosts"
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := config.GetApiBaseUrl() + "/orchestrator/hosts"
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
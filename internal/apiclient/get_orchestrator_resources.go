@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,17 +13,16 @@ import (
 func GetOrchestratorResources(ctx context.Context, config HostConfig) ([]*apimodels.SystemUsageResponse, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	var response []*apimodels.SystemUsageResponse
-	urlHost := helpers.GetHostUrl(config.Host)
 
-	url := helpers.GetHostApiVersionedBaseUrl(urlHost) + "/orchestrator/overview/resources"
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := config.GetApiBaseUrl() + "/orchestrator/overview/resources"
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error orchestrator resources: %v, api message: %s", err, clientResponse.ApiError.Message))
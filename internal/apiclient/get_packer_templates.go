@@ -7,8 +7,6 @@ import (
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
 	"terraform-provider-parallels-desktop/internal/constants"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,15 +15,15 @@ import (
 func GetPackerTemplates(ctx context.Context, config HostConfig, filterField, filterValue string) ([]apimodels.PackerTemplate, diag.Diagnostics) {
 	diagnostic := diag.Diagnostics{}
 	response := make([]apimodels.PackerTemplate, 0)
-	urlHost := helpers.GetHostUrl(config.Host)
-	url := helpers.GetHostApiVersionedBaseUrl(urlHost) + "/templates/packer"
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostic.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostic
 	}
 
+	url := config.GetApiBaseUrl() + "/templates/packer"
+
 	var filter map[string]string
 	if filterField != "" && filterValue != "" {
 		filter = map[string]string{
@@ -33,7 +31,7 @@ func GetPackerTemplates(ctx context.Context, config HostConfig, filterField, fil
 		}
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, &filter, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error getting packer templates: %v, api message: %s", err, clientResponse.ApiError.Message))
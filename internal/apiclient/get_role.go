@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,16 +13,16 @@ import (
 func GetRole(ctx context.Context, config HostConfig, roleId string) (*apimodels.ClaimRoleResponse, diag.Diagnostics) {
 	diagnostic := diag.Diagnostics{}
 	var response apimodels.ClaimRoleResponse
-	urlHost := helpers.GetHostUrl(config.Host)
-	url := fmt.Sprintf("%s/auth/roles/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), roleId)
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostic.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostic
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	url := fmt.Sprintf("%s/auth/roles/%s", config.GetApiBaseUrl(), roleId)
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,26 +13,25 @@ import (
 func GetVm(ctx context.Context, config HostConfig, machineId string) (*apimodels.VirtualMachine, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	var response apimodels.VirtualMachine
-	urlHost := helpers.GetHostUrl(config.Host)
 	if machineId == "" {
 		diagnostics.AddError("There was an error getting the vm", "machineId is empty")
 		return nil, diagnostics
 	}
 
-	var url string
-	if config.IsOrchestrator {
-		url = fmt.Sprintf("%s/orchestrator/machines/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
-	} else {
-		url = fmt.Sprintf("%s/machines/%s", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
-	}
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostics
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	var url string
+	if config.IsOrchestrator {
+		url = fmt.Sprintf("%s/orchestrator/machines/%s", config.GetApiBaseUrl(), machineId)
+	} else {
+		url = fmt.Sprintf("%s/machines/%s", config.GetApiBaseUrl(), machineId)
+	}
+
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			if clientResponse.ApiError.Code == 404 {
@@ -0,0 +1,50 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func GetVmSnapshots(ctx context.Context, config HostConfig, machineId string) (*apimodels.VmSnapshotListResponse, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	var response apimodels.VmSnapshotListResponse
+	if machineId == "" {
+		diagnostics.AddError("There was an error getting the vm snapshots", "machineId is empty")
+		return nil, diagnostics
+	}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return nil, diagnostics
+	}
+
+	var url string
+	if config.IsOrchestrator {
+		url = fmt.Sprintf("%s/orchestrator/machines/%s/snapshots", config.GetApiBaseUrl(), machineId)
+	} else {
+		url = fmt.Sprintf("%s/machines/%s/snapshots", config.GetApiBaseUrl(), machineId)
+	}
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, auth, &response); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			if clientResponse.ApiError.Code == 404 {
+				return nil, diagnostics
+			}
+			tflog.Error(ctx, fmt.Sprintf("Error getting vm snapshots: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error getting the vm snapshots", err.Error())
+		return nil, diagnostics
+	}
+
+	tflog.Info(ctx, "Got "+strconv.Itoa(len(response.Snapshots))+" snapshots for machine "+machineId)
+
+	return &response, diagnostics
+}
@@ -8,8 +8,6 @@ import (
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
 	"terraform-provider-parallels-desktop/internal/constants"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,22 +16,21 @@ import (
 func GetVms(ctx context.Context, config HostConfig, filterField, filterValue string) ([]apimodels.VirtualMachine, diag.Diagnostics) {
 	diagnostic := diag.Diagnostics{}
 	response := make([]apimodels.VirtualMachine, 0)
-	urlHost := helpers.GetHostUrl(config.Host)
 	filterValue = strings.ReplaceAll(filterValue, "\"", "")
-	var url string
-
-	if config.IsOrchestrator {
-		url = helpers.GetHostApiVersionedBaseUrl(urlHost) + "/orchestrator/machines/"
-	} else {
-		url = helpers.GetHostApiVersionedBaseUrl(urlHost) + "/machines/"
-	}
 
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostic.AddError("There was an error getting the authenticator", err.Error())
 		return nil, diagnostic
 	}
 
+	var url string
+	if config.IsOrchestrator {
+		url = config.GetApiBaseUrl() + "/orchestrator/machines/"
+	} else {
+		url = config.GetApiBaseUrl() + "/machines/"
+	}
+
 	var filter map[string]string
 	if filterField != "" && filterValue != "" {
 		filter = map[string]string{
@@ -41,7 +38,7 @@ func GetVms(ctx context.Context, config HostConfig, filterField, filterValue str
 		}
 	}
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	if clientResponse, err := client.GetDataFromClient(ctx, url, &filter, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error getting vms: %v, api message: %s", err, clientResponse.ApiError.Message))
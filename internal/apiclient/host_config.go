@@ -1,7 +1,11 @@
 package apiclient
 
 import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/helpers"
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/ssh"
 )
 
 type HostConfig struct {
@@ -11,5 +15,39 @@ type HostConfig struct {
 	MachineId            string                        `json:"machine_id"`
 	License              string                        `json:"license"`
 	DisableTlsValidation bool                          `json:"disable_tls_validation"`
+	CaCertificate        string                        `json:"ca_certificate,omitempty"`
+	ApiPrefix            string                        `json:"api_prefix,omitempty"`
 	Authorization        *authenticator.Authentication `json:"authorization"`
+	SshTunnel            *ssh.TunnelConfig             `json:"ssh_tunnel,omitempty"`
+}
+
+// GetApiBaseUrl returns the versioned api url of the host using its api prefix and the api
+// version negotiated with it, or the default version if it was not negotiated yet
+func (c HostConfig) GetApiBaseUrl() string {
+	host, prefix := c.getHostAndApiPrefix()
+	return helpers.GetHostApiBaseUrlWithPrefixAndVersion(host, prefix, getNegotiatedApiVersion(c))
+}
+
+// getHostAndApiPrefix returns the host url and its api prefix, a path in the host url
+// takes precedence over the configured api prefix
+func (c HostConfig) getHostAndApiPrefix() (string, string) {
+	host, prefix := helpers.SplitHostApiPrefix(c.Host)
+	if prefix == "" {
+		prefix = c.ApiPrefix
+	}
+
+	return helpers.GetHostUrl(host), helpers.GetHostApiPrefix(prefix)
+}
+
+// NewHttpCaller returns a http caller using the host tls and ssh tunnel settings
+func (c HostConfig) NewHttpCaller(ctx context.Context) *helpers.HttpCaller {
+	client := helpers.NewHttpCaller(ctx, c.DisableTlsValidation)
+	if c.CaCertificate != "" {
+		client = client.WithCaCertificate(c.CaCertificate)
+	}
+	if c.SshTunnel != nil {
+		client = client.WithSshTunnel(c.SshTunnel)
+	}
+
+	return client
 }
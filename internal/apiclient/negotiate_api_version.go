@@ -0,0 +1,94 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/constants"
+	"terraform-provider-parallels-desktop/internal/helpers"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// negotiatedApiVersions keeps the api version negotiated with each host and api prefix
+var negotiatedApiVersions sync.Map
+
+type apiVersionNegotiation struct {
+	version string
+	err     error
+}
+
+// NegotiateApiVersion asks the host for the api versions it supports on the first contact and selects
+// the newest one the provider also supports, the result is kept so each host is only asked once
+func NegotiateApiVersion(ctx context.Context, config HostConfig) (string, error) {
+	host, prefix := config.getHostAndApiPrefix()
+	key := host + prefix
+	if negotiation, ok := negotiatedApiVersions.Load(key); ok {
+		return negotiation.(apiVersionNegotiation).version, negotiation.(apiVersionNegotiation).err
+	}
+
+	url := helpers.GetHostApiBaseUrlWithPrefixAndVersion(host, prefix, "") + "/version"
+
+	var response apimodels.ApiVersionResponse
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.GetDataFromClient(ctx, url, nil, nil, &response); err != nil {
+		// we could not reach the host, this is not cached so we can try again on the next call
+		if clientResponse == nil || clientResponse.StatusCode == 0 {
+			return "", fmt.Errorf("could not reach the Parallels DevOps service at %s%s: %v", host, prefix, err)
+		}
+
+		// any other error, like a failed authentication or a gateway error, is not cached so we can
+		// try again on the next call
+		if clientResponse.StatusCode != http.StatusNotFound && clientResponse.StatusCode != http.StatusMethodNotAllowed {
+			return "", fmt.Errorf("could not get the api version of the Parallels DevOps service at %s%s: %v", host, prefix, err)
+		}
+
+		// services without the version endpoint only support the first api version
+		tflog.Info(ctx, fmt.Sprintf("Could not get the api version of %s%s, using %s: %v", host, prefix, constants.API_VERSION, err))
+		response = apimodels.ApiVersionResponse{}
+	}
+
+	negotiation := apiVersionNegotiation{}
+	negotiation.version, negotiation.err = selectApiVersion(host+prefix, response)
+	negotiatedApiVersions.Store(key, negotiation)
+	if negotiation.err == nil {
+		tflog.Info(ctx, "Using api version "+negotiation.version+" with "+host+prefix)
+	}
+
+	return negotiation.version, negotiation.err
+}
+
+func selectApiVersion(host string, response apimodels.ApiVersionResponse) (string, error) {
+	if len(response.ApiVersions) == 0 {
+		return constants.API_VERSION, nil
+	}
+
+	for i := len(constants.SupportedApiVersions) - 1; i >= 0; i-- {
+		for _, apiVersion := range response.ApiVersions {
+			if strings.EqualFold(strings.Trim(apiVersion, "/"), constants.SupportedApiVersions[i]) {
+				return constants.SupportedApiVersions[i], nil
+			}
+		}
+	}
+
+	serviceVersion := response.Version
+	if serviceVersion == "" {
+		serviceVersion = "unknown"
+	}
+
+	return "", fmt.Errorf("the Parallels DevOps service at %s (version %s) supports the api versions %s but this provider only supports %s, please use a provider version compatible with the service",
+		host, serviceVersion, strings.Join(response.ApiVersions, ", "), strings.Join(constants.SupportedApiVersions, ", "))
+}
+
+func getNegotiatedApiVersion(config HostConfig) string {
+	host, prefix := config.getHostAndApiPrefix()
+	if negotiation, ok := negotiatedApiVersions.Load(host + prefix); ok && negotiation.(apiVersionNegotiation).err == nil {
+		return negotiation.(apiVersionNegotiation).version
+	}
+
+	return constants.API_VERSION
+}
@@ -0,0 +1,61 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"terraform-provider-parallels-desktop/internal/constants"
+)
+
+func TestNegotiateApiVersionStatusCodes(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{name: "version endpoint not found", statusCode: http.StatusNotFound},
+		{name: "version endpoint method not allowed", statusCode: http.StatusMethodNotAllowed},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, wantErr: true},
+		{name: "bad gateway", statusCode: http.StatusBadGateway, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.statusCode)
+			}))
+			t.Cleanup(server.Close)
+
+			config := HostConfig{Host: server.URL}
+			for i := 0; i < 2; i++ {
+				version, err := NegotiateApiVersion(context.Background(), config)
+				if tt.wantErr {
+					if err == nil {
+						t.Fatalf("expected an error, got the version %s", version)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if version != constants.API_VERSION {
+					t.Errorf("version = %s, want %s", version, constants.API_VERSION)
+				}
+			}
+
+			// only the services without the version endpoint are cached
+			wantCalls := int32(1)
+			if tt.wantErr {
+				wantCalls = 2
+			}
+			if got := calls.Load(); got != wantCalls {
+				t.Errorf("calls = %d, want %d", got, wantCalls)
+			}
+		})
+	}
+}
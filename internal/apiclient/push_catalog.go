@@ -0,0 +1,42 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// PushCatalog packs a machine and pushes it to a catalog, when using an orchestrator the HostId can
+// be set to push a machine from a specific host
+func PushCatalog(ctx context.Context, config HostConfig, request apimodels.PushCatalogRequest) (*apimodels.CatalogManifest, diag.Diagnostics) {
+	diagnostic := diag.Diagnostics{}
+	var response apimodels.CatalogManifest
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostic.AddError("There was an error getting the authenticator", err.Error())
+		return nil, diagnostic
+	}
+
+	url := config.GetApiBaseUrl() + "/catalog/push"
+	if config.IsOrchestrator && config.HostId != "" {
+		url = fmt.Sprintf("%s/orchestrator/hosts/%s/catalog/push", config.GetApiBaseUrl(), config.HostId)
+	}
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.PostDataToClient(ctx, url, nil, request, auth, &response); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error pushing catalog: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostic.AddError("There was an error pushing the catalog "+request.CatalogId, err.Error())
+		return nil, diagnostic
+	}
+
+	tflog.Info(ctx, "Pushed catalog "+request.CatalogId+" version "+request.Version)
+
+	return &response, diagnostic
+}
@@ -0,0 +1,43 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func RemoveRoleFromUser(ctx context.Context, config HostConfig, userId string, roleId string) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+	if userId == "" || roleId == "" {
+		diagnostic.AddError("There was an error removing the role from the user", "user id or role id is empty")
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Removing Role "+roleId+" from User "+userId)
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostic.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostic
+	}
+
+	url := fmt.Sprintf("%s/auth/users/%s/roles/%s", config.GetApiBaseUrl(), userId, roleId)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.DeleteDataFromClient(ctx, url, nil, auth, nil); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			if clientResponse.ApiError.Code == 404 {
+				tflog.Info(ctx, "Role "+roleId+" not found in user "+userId)
+				return diagnostic
+			}
+		}
+		diagnostic.AddError("There was an error removing the role from the user", err.Error())
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Removed role "+roleId+" from user "+userId)
+
+	return diagnostic
+}
@@ -0,0 +1,38 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func RevertVmSnapshot(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, snapshotId string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error reverting the snapshot", "vm is nil")
+		return diagnostics
+	}
+	if snapshotId == "" {
+		diagnostics.AddError("There was an error reverting the snapshot", "snapshot id is empty")
+		return diagnostics
+	}
+
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("snapshot-switch")
+	op.WithOption("id", snapshotId)
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Reverted machine "+vm.Name+" to snapshot "+snapshotId)
+
+	return diagnostics
+}
@@ -0,0 +1,40 @@
+package apiclient
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func SetMachineBootOrder(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, devices []string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error setting the machine boot order", "vm is nil")
+		return diagnostics
+	}
+	if len(devices) == 0 {
+		diagnostics.AddError("There was an error setting the machine boot order", "boot order is empty")
+		return diagnostics
+	}
+
+	bootOrder := strings.Join(devices, " ")
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	op.WithOption("device-bootorder", bootOrder)
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Set boot order "+bootOrder+" for machine "+vm.Name)
+
+	return diagnostics
+}
@@ -0,0 +1,45 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SetMachineInstallMedia attaches the image to the machine cdrom, an empty image path detaches it
+func SetMachineInstallMedia(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, imagePath string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error setting the machine install media", "vm is nil")
+		return diagnostics
+	}
+
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	op.WithOption("device-set", "cdrom0")
+	if imagePath != "" {
+		op.WithOption("image", imagePath)
+		op.WithFlag("connect")
+	} else {
+		op.WithFlag("disconnect")
+	}
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	if imagePath != "" {
+		tflog.Info(ctx, "Attached install media "+imagePath+" to machine "+vm.Name)
+	} else {
+		tflog.Info(ctx, "Detached install media from machine "+vm.Name)
+	}
+
+	return diagnostics
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
-	"terraform-provider-parallels-desktop/internal/helpers"
-	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,21 +23,20 @@ const (
 
 func SetMachineState(ctx context.Context, config HostConfig, machineId string, op MachineStateOp) (bool, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
-	urlHost := helpers.GetHostUrl(config.Host)
 
-	var url string
-	if config.IsOrchestrator {
-		url = fmt.Sprintf("%s/orchestrator/machines/%s/set", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
-	} else {
-		url = fmt.Sprintf("%s/machines/%s/set", helpers.GetHostApiVersionedBaseUrl(urlHost), machineId)
-	}
-
-	auth, err := authenticator.GetAuthenticator(ctx, urlHost, config.License, config.Authorization, config.DisableTlsValidation)
+	auth, err := getAuthenticator(ctx, config)
 	if err != nil {
 		diagnostics.AddError("There was an error getting the authenticator", err.Error())
 		return false, diagnostics
 	}
 
+	var url string
+	if config.IsOrchestrator {
+		url = fmt.Sprintf("%s/orchestrator/machines/%s/set", config.GetApiBaseUrl(), machineId)
+	} else {
+		url = fmt.Sprintf("%s/machines/%s/set", config.GetApiBaseUrl(), machineId)
+	}
+
 	vm, diag := GetVm(ctx, config, machineId)
 	if diag.HasError() {
 		diagnostics = append(diagnostics, diag...)
@@ -61,7 +58,7 @@ func SetMachineState(ctx context.Context, config HostConfig, machineId string, o
 	setOp.WithOperation(string(op))
 	setOp.Append()
 
-	client := helpers.NewHttpCaller(ctx, config.DisableTlsValidation)
+	client := config.NewHttpCaller(ctx)
 	var response apimodels.VmConfigResponse
 	if clientResponse, err := client.PutDataToClient(ctx, url, nil, configSet, auth, &response); err != nil {
 		if clientResponse != nil && clientResponse.ApiError != nil {
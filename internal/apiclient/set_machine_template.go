@@ -0,0 +1,39 @@
+package apiclient
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func SetMachineTemplate(ctx context.Context, config HostConfig, vm *apimodels.VirtualMachine, isTemplate bool) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if vm == nil {
+		diagnostics.AddError("There was an error setting the machine template flag", "vm is nil")
+		return diagnostics
+	}
+
+	value := "off"
+	if isTemplate {
+		value = "on"
+	}
+
+	configSet := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(configSet)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	op.WithOption("template", value)
+	op.Append()
+
+	if _, configDiag := ConfigureMachine(ctx, config, vm.ID, configSet); configDiag.HasError() {
+		diagnostics.Append(configDiag...)
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Set template "+value+" for machine "+vm.Name)
+
+	return diagnostics
+}
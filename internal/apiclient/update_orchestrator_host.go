@@ -0,0 +1,86 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpdateOrchestratorHost updates the registration details of a host already registered in the orchestrator
+func UpdateOrchestratorHost(ctx context.Context, config HostConfig, hostId string, request apimodels.OrchestratorHostRequest) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostics
+	}
+
+	url := fmt.Sprintf("%s/orchestrator/hosts/%s", config.GetApiBaseUrl(), hostId)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.PutDataToClient(ctx, url, nil, request, auth, nil); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error updating the host: %v, api message: %s", err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error updating the host in the orchestrator", err.Error())
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Updated orchestrator host "+hostId)
+
+	return diagnostics
+}
+
+// SetOrchestratorHostEnabled enables or disables a host in the orchestrator, disabled hosts are not
+// used by the orchestrator at all
+func SetOrchestratorHostEnabled(ctx context.Context, config HostConfig, hostId string, enabled bool) diag.Diagnostics {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	return putOrchestratorHostAction(ctx, config, "/"+action, hostId, action)
+}
+
+// SetOrchestratorHostMaintenance puts a host in or out of maintenance mode, a host in maintenance keeps
+// its machines running but the orchestrator will not schedule new machines on it
+func SetOrchestratorHostMaintenance(ctx context.Context, config HostConfig, hostId string, maintenance bool) diag.Diagnostics {
+	action := "disable"
+	if maintenance {
+		action = "enable"
+	}
+
+	return putOrchestratorHostAction(ctx, config, "/maintenance/"+action, hostId, action+" maintenance")
+}
+
+// putOrchestratorHostAction calls the host action path, the url is only built once the api version
+// was negotiated by the authenticator
+func putOrchestratorHostAction(ctx context.Context, config HostConfig, actionPath string, hostId string, action string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	auth, err := getAuthenticator(ctx, config)
+	if err != nil {
+		diagnostics.AddError("There was an error getting the authenticator", err.Error())
+		return diagnostics
+	}
+
+	url := fmt.Sprintf("%s/orchestrator/hosts/%s%s", config.GetApiBaseUrl(), hostId, actionPath)
+
+	client := config.NewHttpCaller(ctx)
+	if clientResponse, err := client.PutDataToClient(ctx, url, nil, nil, auth, nil); err != nil {
+		if clientResponse != nil && clientResponse.ApiError != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error trying to %s the host: %v, api message: %s", action, err, clientResponse.ApiError.Message))
+		}
+		diagnostics.AddError("There was an error trying to "+action+" the host "+hostId, err.Error())
+		return diagnostics
+	}
+
+	tflog.Info(ctx, "Orchestrator host "+hostId+" "+action+" done")
+
+	return diagnostics
+}
@@ -0,0 +1,92 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	data_models "terraform-provider-parallels-desktop/internal/apikey/models"
+	"terraform-provider-parallels-desktop/internal/apikey/schemas"
+	"terraform-provider-parallels-desktop/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &ApiKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApiKeyDataSource{}
+)
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &ApiKeyDataSource{}
+}
+
+type ApiKeyDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *ApiKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *ApiKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (d *ApiKeyDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.ApiKeyDataSourceSchemaV0
+}
+
+func (d *ApiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.ApiKeyDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	lookup := data.ID.ValueString()
+	if lookup == "" {
+		lookup = data.Name.ValueString()
+	}
+
+	apiKey, diag := apiclient.GetApiKey(ctx, hostConfig, lookup)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if apiKey == nil {
+		resp.Diagnostics.AddError("Api key not found", "Could not find the api key "+lookup+" in the host")
+		return
+	}
+
+	data.FromApiModel(apiKey)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApiKeyDataSourceModelV0 represents the data source schema for the api key data source.
+type ApiKeyDataSourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Name          types.String                  `tfsdk:"name"`
+	Key           types.String                  `tfsdk:"key"`
+	Revoked       types.Bool                    `tfsdk:"revoked"`
+	CreatedAt     types.String                  `tfsdk:"created_at"`
+	UpdatedAt     types.String                  `tfsdk:"updated_at"`
+}
+
+// FromApiModel sets the api key details from the host
+func (m *ApiKeyDataSourceModelV0) FromApiModel(apiKey *apimodels.ApiKeyResponse) {
+	m.ID = types.StringValue(apiKey.ID)
+	m.Name = types.StringValue(apiKey.Name)
+	m.Key = types.StringValue(apiKey.Key)
+	m.Revoked = types.BoolValue(apiKey.Revoked)
+	m.CreatedAt = types.StringValue(apiKey.CreatedAt)
+	m.UpdatedAt = types.StringValue(apiKey.UpdatedAt)
+}
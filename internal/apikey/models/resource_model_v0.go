@@ -0,0 +1,173 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/helpers"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const day = 24 * time.Hour
+
+// ApiKeyResourceModelV0 represents the resource schema for the api key resource.
+type ApiKeyResourceModelV0 struct {
+	Authenticator      *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel          *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host               types.String                  `tfsdk:"host"`
+	ID                 types.String                  `tfsdk:"id"`
+	Name               types.String                  `tfsdk:"name"`
+	Key                types.String                  `tfsdk:"key"`
+	Secret             types.String                  `tfsdk:"secret"`
+	ExpiryDays         types.Int64                   `tfsdk:"expiry_days"`
+	RotationDays       types.Int64                   `tfsdk:"rotation_days"`
+	OverlapDays        types.Int64                   `tfsdk:"overlap_days"`
+	CreatedAt          types.String                  `tfsdk:"created_at"`
+	ExpiresAt          types.String                  `tfsdk:"expires_at"`
+	CurrentKey         types.String                  `tfsdk:"current_key"`
+	ApiKey             types.String                  `tfsdk:"api_key"`
+	PreviousID         types.String                  `tfsdk:"previous_id"`
+	PreviousApiKey     types.String                  `tfsdk:"previous_api_key"`
+	PreviousValidUntil types.String                  `tfsdk:"previous_valid_until"`
+}
+
+// ToRequest converts the model to the request used to create the api key, rotated keys get the
+// rotation time appended to the name and key as the host requires them to be unique
+func (m *ApiKeyResourceModelV0) ToRequest(suffix string) apimodels.ApiKeyRequest {
+	request := apimodels.ApiKeyRequest{
+		Name:   m.Name.ValueString(),
+		Key:    m.Key.ValueString(),
+		Secret: m.Secret.ValueString(),
+	}
+	if suffix != "" {
+		request.Name = fmt.Sprintf("%s-%s", request.Name, suffix)
+		request.Key = fmt.Sprintf("%s-%s", request.Key, suffix)
+	}
+
+	return request
+}
+
+// FromApiModel sets the api key details from the host, the name and key are only taken from the
+// host when importing as rotated keys have the rotation time appended to them
+func (m *ApiKeyResourceModelV0) FromApiModel(apiKey *apimodels.ApiKeyResponse) {
+	m.ID = types.StringValue(apiKey.ID)
+	if m.Name.IsNull() {
+		m.Name = types.StringValue(apiKey.Name)
+	}
+	if apiKey.Key != "" {
+		m.CurrentKey = types.StringValue(apiKey.Key)
+	}
+	if m.CreatedAt.IsNull() {
+		if createdAt, err := time.Parse(time.RFC3339, apiKey.CreatedAt); err == nil {
+			m.CreatedAt = types.StringValue(createdAt.UTC().Format(time.RFC3339))
+		}
+	}
+
+	m.SetExpiresAt()
+	m.SetApiKey()
+}
+
+// SetApiKey sets the composite key used by the authenticator from the current key and secret
+func (m *ApiKeyResourceModelV0) SetApiKey() {
+	if m.CurrentKey.IsNull() || m.CurrentKey.IsUnknown() || m.Secret.IsNull() || m.Secret.IsUnknown() {
+		m.ApiKey = types.StringNull()
+		return
+	}
+
+	m.ApiKey = types.StringValue(helpers.Base64Encode(fmt.Sprintf("%s:%s", m.CurrentKey.ValueString(), m.Secret.ValueString())))
+}
+
+// SetExpiresAt sets when the current key expires from its creation time and the expiry days
+func (m *ApiKeyResourceModelV0) SetExpiresAt() {
+	createdAt, ok := m.GetCreatedAt()
+	if !ok || m.ExpiryDays.IsNull() || m.ExpiryDays.IsUnknown() {
+		m.ExpiresAt = types.StringNull()
+		return
+	}
+
+	m.ExpiresAt = types.StringValue(createdAt.Add(time.Duration(m.ExpiryDays.ValueInt64()) * day).Format(time.RFC3339))
+}
+
+// GetCreatedAt returns when the current key was created, it is not known for keys imported from
+// hosts that do not return it until SetMissingCreatedAt sets it
+func (m *ApiKeyResourceModelV0) GetCreatedAt() (time.Time, bool) {
+	createdAt, err := time.Parse(time.RFC3339, m.CreatedAt.ValueString())
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return createdAt, true
+}
+
+// SetMissingCreatedAt sets the creation time to now for keys imported from hosts that do not
+// return it, without it the key would never be rotated or expire. It returns true if it was set
+func (m *ApiKeyResourceModelV0) SetMissingCreatedAt(now time.Time) bool {
+	if _, ok := m.GetCreatedAt(); ok {
+		return false
+	}
+
+	m.CreatedAt = types.StringValue(now.UTC().Format(time.RFC3339))
+	m.SetExpiresAt()
+	return true
+}
+
+// IsExpired returns true if the current key has an expiry and it has passed
+func (m *ApiKeyResourceModelV0) IsExpired(now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(expiresAt)
+}
+
+// IsRotationDue returns true if the key needs to be rotated, either because it is older than the
+// rotation days or because it expired before an apply could rotate it
+func (m *ApiKeyResourceModelV0) IsRotationDue(now time.Time) bool {
+	if m.RotationDays.IsNull() || m.RotationDays.IsUnknown() {
+		return false
+	}
+
+	createdAt, ok := m.GetCreatedAt()
+	if !ok {
+		return false
+	}
+
+	return !now.Before(createdAt.Add(time.Duration(m.RotationDays.ValueInt64())*day)) || m.IsExpired(now)
+}
+
+// IsPreviousExpired returns true if the key replaced by the last rotation is past its overlap window
+func (m *ApiKeyResourceModelV0) IsPreviousExpired(now time.Time) bool {
+	if m.PreviousID.ValueString() == "" {
+		return false
+	}
+
+	validUntil, err := time.Parse(time.RFC3339, m.PreviousValidUntil.ValueString())
+	if err != nil {
+		return true
+	}
+
+	return !now.Before(validUntil)
+}
+
+// GetPreviousValidUntil returns until when the current key is kept after being replaced, the
+// overlap window never goes past the key expiry
+func (m *ApiKeyResourceModelV0) GetPreviousValidUntil(now time.Time) time.Time {
+	validUntil := now.Add(time.Duration(m.OverlapDays.ValueInt64()) * day)
+	if expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString()); err == nil && expiresAt.Before(validUntil) {
+		return expiresAt
+	}
+
+	return validUntil
+}
+
+// ClearPrevious removes the key replaced by the last rotation from the model
+func (m *ApiKeyResourceModelV0) ClearPrevious() {
+	m.PreviousID = types.StringNull()
+	m.PreviousApiKey = types.StringNull()
+	m.PreviousValidUntil = types.StringNull()
+}
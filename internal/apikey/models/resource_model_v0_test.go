@@ -0,0 +1,157 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testNow = time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+func newTestApiKey(createdAt time.Time, expiryDays, rotationDays types.Int64) ApiKeyResourceModelV0 {
+	m := ApiKeyResourceModelV0{
+		ExpiryDays:   expiryDays,
+		RotationDays: rotationDays,
+		CreatedAt:    types.StringValue(createdAt.Format(time.RFC3339)),
+	}
+	m.SetExpiresAt()
+	return m
+}
+
+func TestSetExpiresAt(t *testing.T) {
+	m := newTestApiKey(testNow, types.Int64Value(30), types.Int64Null())
+	if want := testNow.Add(30 * day).Format(time.RFC3339); m.ExpiresAt.ValueString() != want {
+		t.Errorf("expires at = %q, want %q", m.ExpiresAt.ValueString(), want)
+	}
+
+	m = newTestApiKey(testNow, types.Int64Null(), types.Int64Null())
+	if !m.ExpiresAt.IsNull() {
+		t.Errorf("expires at = %q, want null without expiry days", m.ExpiresAt.ValueString())
+	}
+
+	m = ApiKeyResourceModelV0{ExpiryDays: types.Int64Value(30), CreatedAt: types.StringNull()}
+	m.SetExpiresAt()
+	if !m.ExpiresAt.IsNull() {
+		t.Errorf("expires at = %q, want null without a creation time", m.ExpiresAt.ValueString())
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	tests := []struct {
+		name       string
+		createdAt  time.Time
+		expiryDays types.Int64
+		want       bool
+	}{
+		{name: "not expired", createdAt: testNow.Add(-10 * day), expiryDays: types.Int64Value(30)},
+		{name: "expired", createdAt: testNow.Add(-31 * day), expiryDays: types.Int64Value(30), want: true},
+		{name: "expires now", createdAt: testNow.Add(-30 * day), expiryDays: types.Int64Value(30), want: true},
+		{name: "no expiry", createdAt: testNow.Add(-365 * day), expiryDays: types.Int64Null()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestApiKey(tt.createdAt, tt.expiryDays, types.Int64Null())
+			if got := m.IsExpired(testNow); got != tt.want {
+				t.Errorf("is expired = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRotationDue(t *testing.T) {
+	tests := []struct {
+		name         string
+		createdAt    time.Time
+		expiryDays   types.Int64
+		rotationDays types.Int64
+		want         bool
+	}{
+		{name: "not due", createdAt: testNow.Add(-10 * day), rotationDays: types.Int64Value(20), expiryDays: types.Int64Null()},
+		{name: "due", createdAt: testNow.Add(-20 * day), rotationDays: types.Int64Value(20), expiryDays: types.Int64Null(), want: true},
+		{name: "expired before the rotation", createdAt: testNow.Add(-15 * day), rotationDays: types.Int64Value(20), expiryDays: types.Int64Value(10), want: true},
+		{name: "no rotation", createdAt: testNow.Add(-365 * day), rotationDays: types.Int64Null(), expiryDays: types.Int64Value(30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestApiKey(tt.createdAt, tt.expiryDays, tt.rotationDays)
+			if got := m.IsRotationDue(testNow); got != tt.want {
+				t.Errorf("is rotation due = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetMissingCreatedAt(t *testing.T) {
+	// an imported key from a host that does not return its creation time
+	m := ApiKeyResourceModelV0{
+		ExpiryDays:   types.Int64Value(30),
+		RotationDays: types.Int64Value(20),
+		CreatedAt:    types.StringNull(),
+	}
+	m.FromApiModel(&apimodels.ApiKeyResponse{ID: "key-id", Name: "ci", Key: "ci"})
+	if m.IsRotationDue(testNow.Add(365*day)) || m.IsExpired(testNow.Add(365*day)) {
+		t.Fatal("a key without a creation time cannot be rotated or expire")
+	}
+
+	if !m.SetMissingCreatedAt(testNow) {
+		t.Fatal("expected the creation time to be set")
+	}
+	if m.CreatedAt.ValueString() != testNow.Format(time.RFC3339) {
+		t.Errorf("created at = %q, want %q", m.CreatedAt.ValueString(), testNow.Format(time.RFC3339))
+	}
+	if want := testNow.Add(30 * day).Format(time.RFC3339); m.ExpiresAt.ValueString() != want {
+		t.Errorf("expires at = %q, want %q", m.ExpiresAt.ValueString(), want)
+	}
+	if m.IsRotationDue(testNow.Add(19 * day)) {
+		t.Error("the rotation is not due before the rotation days from the import")
+	}
+	if !m.IsRotationDue(testNow.Add(20 * day)) {
+		t.Error("the rotation is due after the rotation days from the import")
+	}
+
+	if m.SetMissingCreatedAt(testNow.Add(day)) {
+		t.Error("a known creation time is not replaced")
+	}
+}
+
+func TestIsPreviousExpired(t *testing.T) {
+	tests := []struct {
+		name       string
+		previousId types.String
+		validUntil types.String
+		want       bool
+	}{
+		{name: "no previous key", previousId: types.StringNull(), validUntil: types.StringNull()},
+		{name: "within the overlap", previousId: types.StringValue("old"), validUntil: types.StringValue(testNow.Add(day).Format(time.RFC3339))},
+		{name: "past the overlap", previousId: types.StringValue("old"), validUntil: types.StringValue(testNow.Add(-day).Format(time.RFC3339)), want: true},
+		{name: "unknown overlap", previousId: types.StringValue("old"), validUntil: types.StringNull(), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := ApiKeyResourceModelV0{PreviousID: tt.previousId, PreviousValidUntil: tt.validUntil}
+			if got := m.IsPreviousExpired(testNow); got != tt.want {
+				t.Errorf("is previous expired = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPreviousValidUntil(t *testing.T) {
+	m := newTestApiKey(testNow.Add(-20*day), types.Int64Value(30), types.Int64Value(20))
+	m.OverlapDays = types.Int64Value(7)
+	if got, want := m.GetPreviousValidUntil(testNow), testNow.Add(7*day); !got.Equal(want) {
+		t.Errorf("valid until = %v, want %v", got, want)
+	}
+
+	// the overlap window never goes past the key expiry
+	m.OverlapDays = types.Int64Value(15)
+	if got, want := m.GetPreviousValidUntil(testNow), testNow.Add(10*day); !got.Equal(want) {
+		t.Errorf("valid until = %v, want %v", got, want)
+	}
+}
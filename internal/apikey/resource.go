@@ -0,0 +1,422 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	resource_models "terraform-provider-parallels-desktop/internal/apikey/models"
+	"terraform-provider-parallels-desktop/internal/apikey/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/helpers"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// generatedSecretLength is the length of the secrets generated for api keys without one
+const generatedSecretLength = 32
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ApiKeyResource{}
+	_ resource.ResourceWithImportState = &ApiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &ApiKeyResource{}
+)
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation.
+type ApiKeyResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetApiKeySchemaV0(ctx)
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.ApiKeyResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventApiKey, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+
+	existingApiKey, diag := apiclient.GetApiKey(ctx, hostConfig, data.Name.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if existingApiKey != nil {
+		resp.Diagnostics.AddError("api key already exists", fmt.Sprintf("api key %s already exists, import it to manage it with terraform", data.Name.ValueString()))
+		return
+	}
+
+	if diag := createApiKey(ctx, hostConfig, &data, ""); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	data.ClearPrevious()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.ApiKeyResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventApiKey, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	apiKey, diag := apiclient.GetApiKey(ctx, hostConfig, data.ID.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	// the api key was deleted outside terraform, it needs to be created again
+	if apiKey == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.FromApiModel(apiKey)
+	if data.SetMissingCreatedAt(time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Api key creation time unknown",
+			"The host did not return when the api key "+data.ID.ValueString()+" was created, its rotation and expiry are counted from now",
+		)
+	}
+
+	if data.PreviousID.ValueString() != "" {
+		previousApiKey, diag := apiclient.GetApiKey(ctx, hostConfig, data.PreviousID.ValueString())
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		if previousApiKey == nil {
+			data.ClearPrevious()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.ApiKeyResourceModelV0
+	var currentData resource_models.ApiKeyResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventApiKey, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	now := time.Now().UTC()
+
+	// the plan marks the id as unknown when the key is due to be rotated
+	if data.ID.IsUnknown() {
+		resp.Diagnostics.Append(r.rotate(ctx, hostConfig, &data, currentData, now)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		if data.PreviousID.IsUnknown() {
+			tflog.Info(ctx, "Deleting api key "+currentData.PreviousID.ValueString()+" replaced by the last rotation")
+			if diag := apiclient.DeleteApiKey(ctx, hostConfig, currentData.PreviousID.ValueString()); diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+			data.ClearPrevious()
+		}
+
+		// an imported api key without a configured secret keeps working with the secret the host
+		// has, we just cannot build the composite key for it
+		if data.Secret.IsUnknown() {
+			data.Secret = types.StringNull()
+		}
+		if data.CurrentKey.IsUnknown() {
+			data.CurrentKey = currentData.CurrentKey
+		}
+		if data.CreatedAt.IsUnknown() {
+			data.CreatedAt = currentData.CreatedAt
+		}
+		data.SetExpiresAt()
+		data.SetApiKey()
+	}
+
+	// the first update after an import has set the key and secret we could not read back
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, nil)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan checks the rotation and expiry of the api key, a key due to be rotated plans a new
+// key and keeps the current one for the overlap window while an expired key is replaced
+func (r *ApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var data resource_models.ApiKeyResourceModelV0
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RotationDays.IsNull() && !data.ExpiryDays.IsNull() && data.RotationDays.ValueInt64() >= data.ExpiryDays.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("rotation_days"), "Invalid rotation days", "The api key needs to be rotated before it expires, rotation_days must be lower than expiry_days")
+		return
+	}
+
+	// this is a new api key, the times are only known once it is created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var currentData resource_models.ApiKeyResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now().UTC()
+	currentData.ExpiryDays = data.ExpiryDays
+	currentData.RotationDays = data.RotationDays
+	currentData.SetExpiresAt()
+
+	switch {
+	case currentData.IsRotationDue(now):
+		tflog.Info(ctx, "Api key "+currentData.ID.ValueString()+" is due to be rotated")
+		data.ID = types.StringUnknown()
+		data.Secret = types.StringUnknown()
+		data.CreatedAt = types.StringUnknown()
+		data.ExpiresAt = types.StringUnknown()
+		data.CurrentKey = types.StringUnknown()
+		data.ApiKey = types.StringUnknown()
+		data.PreviousID = types.StringUnknown()
+		data.PreviousApiKey = types.StringUnknown()
+		data.PreviousValidUntil = types.StringUnknown()
+	case currentData.RotationDays.IsNull() && currentData.IsExpired(now):
+		tflog.Info(ctx, "Api key "+currentData.ID.ValueString()+" expired, it will be replaced")
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+		data.ID = types.StringUnknown()
+		data.Secret = types.StringUnknown()
+		data.CreatedAt = types.StringUnknown()
+		data.ExpiresAt = types.StringUnknown()
+		data.CurrentKey = types.StringUnknown()
+		data.ApiKey = types.StringUnknown()
+	default:
+		data.ExpiresAt = currentData.ExpiresAt
+		if currentData.IsPreviousExpired(now) {
+			data.PreviousID = types.StringUnknown()
+			data.PreviousApiKey = types.StringUnknown()
+			data.PreviousValidUntil = types.StringUnknown()
+		}
+		if !data.Secret.Equal(currentData.Secret) {
+			data.ApiKey = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.ApiKeyResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventApiKey, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	if data.PreviousID.ValueString() != "" {
+		if diag := apiclient.DeleteApiKey(ctx, hostConfig, data.PreviousID.ValueString()); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if diag := apiclient.DeleteApiKey(ctx, hostConfig, data.ID.ValueString()); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+}
+
+// ImportState sets the host, credentials and api key id from the import id in the format
+// [username:password@]host@api_key_id_or_name, the Read then rebuilds the rest of the state
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, auth, apiKeyId, err := common.ParseImportId(req.ID, "api_key_id_or_name", "api key id or name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), apiKeyId)...)
+	if auth != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), auth)...)
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, planmodifiers.ImportedPrivateStateValue)...)
+}
+
+func (r *ApiKeyResource) getHostConfig(data resource_models.ApiKeyResourceModelV0) apiclient.HostConfig {
+	return apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+}
+
+// rotate creates the replacement key and keeps the current one as the previous key until the
+// overlap window ends, only one previous key is kept so an older one is deleted first
+func (r *ApiKeyResource) rotate(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.ApiKeyResourceModelV0, currentData resource_models.ApiKeyResourceModelV0, now time.Time) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if currentData.PreviousID.ValueString() != "" {
+		tflog.Info(ctx, "Deleting api key "+currentData.PreviousID.ValueString()+" replaced by the previous rotation")
+		if diag := apiclient.DeleteApiKey(ctx, hostConfig, currentData.PreviousID.ValueString()); diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+	}
+
+	if diag := createApiKey(ctx, hostConfig, data, now.Format("20060102150405")); diag.HasError() {
+		diagnostics.Append(diag...)
+		return diagnostics
+	}
+
+	validUntil := currentData.GetPreviousValidUntil(now)
+	if !now.Before(validUntil) {
+		tflog.Info(ctx, "Deleting expired api key "+currentData.ID.ValueString())
+		if diag := apiclient.DeleteApiKey(ctx, hostConfig, currentData.ID.ValueString()); diag.HasError() {
+			diagnostics.Append(diag...)
+		}
+		data.ClearPrevious()
+		return diagnostics
+	}
+
+	data.PreviousID = currentData.ID
+	data.PreviousApiKey = currentData.ApiKey
+	data.PreviousValidUntil = types.StringValue(validUntil.Format(time.RFC3339))
+	return diagnostics
+}
+
+// createApiKey creates a new api key generating the secret when it is not configured, the suffix
+// is appended to the name and key of rotated keys
+func createApiKey(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.ApiKeyResourceModelV0, suffix string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if data.Secret.IsUnknown() || data.Secret.IsNull() {
+		secret, err := helpers.RandomString(generatedSecretLength)
+		if err != nil {
+			diagnostics.AddError("There was an error generating the api key secret", err.Error())
+			return diagnostics
+		}
+		data.Secret = types.StringValue(secret)
+	}
+
+	request := data.ToRequest(suffix)
+	tflog.Info(ctx, "Creating api key "+request.Name)
+	apiKey, diag := apiclient.CreateApiKey(ctx, hostConfig, request)
+	if diag.HasError() {
+		diagnostics.Append(diag...)
+		return diagnostics
+	}
+
+	data.ID = types.StringValue(apiKey.ID)
+	data.CurrentKey = types.StringValue(request.Key)
+	data.CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.SetExpiresAt()
+	data.SetApiKey()
+	return diagnostics
+}
@@ -0,0 +1,58 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ApiKeyDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Api Key Data Source, looks up an api key in a Parallels Desktop DevOps host by its id or name, the secret is never returned",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Required:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Api key id",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRoot("id"),
+					path.MatchRoot("name"),
+				}...),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Api key name",
+			Optional:            true,
+			Computed:            true,
+		},
+		"key": schema.StringAttribute{
+			MarkdownDescription: "Api key",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"revoked": schema.BoolAttribute{
+			MarkdownDescription: "Whether the api key was revoked",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "When the api key was created",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "When the api key was last updated",
+			Computed:            true,
+		},
+	},
+}
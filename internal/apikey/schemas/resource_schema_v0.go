@@ -0,0 +1,151 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func GetApiKeySchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Desktop DevOps Api Key Resource\n Use this to create a single api key in a Parallels Desktop DevOps host, the `api_key` output can be used in the `authenticator` block of other resources.\n\n" +
+			"Api keys can expire and be rotated, the host does not enforce the expiry so the key is replaced or rotated on the first apply after it is due. Run the apply on a schedule to keep the keys within your policy.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+		},
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the api key currently in use",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Api key name, rotated keys get the rotation time appended to it",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Api key, rotated keys get the rotation time appended to it",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Api key secret, a random secret is generated when it is not set. It cannot be read back from the host so imported api keys take it from the configuration",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("expiry_days"),
+						path.MatchRoot("rotation_days"),
+					}...),
+				},
+			},
+			"expiry_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days after its creation the api key expires, an expired key is replaced by a new one. Requires the secret to be generated",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days after its creation a replacement api key is created, the previous key is kept valid for `overlap_days`. Must be lower than `expiry_days` when both are set. Requires the secret to be generated",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"overlap_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the previous api key is kept after a rotation, it is never kept after it expires. Defaults to `7`",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the api key currently in use was created, keys imported from hosts that do not return it use the import time",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the api key currently in use expires",
+				Computed:            true,
+			},
+			"current_key": schema.StringAttribute{
+				MarkdownDescription: "Api key currently in use, it includes the rotation time for rotated keys",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "Api key composite of the key currently in use",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the api key replaced by the last rotation while it is still valid",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_api_key": schema.StringAttribute{
+				MarkdownDescription: "Api key composite of the api key replaced by the last rotation while it is still valid",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_valid_until": schema.StringAttribute{
+				MarkdownDescription: "When the api key replaced by the last rotation is deleted",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package authorization
 
 import (
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -29,7 +31,7 @@ var (
 					Required:            true,
 					Sensitive:           true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						planmodifiers.StringRequiresReplaceUnlessImported(),
 					},
 				},
 				"secret": schema.StringAttribute{
@@ -37,7 +39,7 @@ var (
 					Required:            true,
 					Sensitive:           true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						planmodifiers.StringRequiresReplaceUnlessImported(),
 					},
 				},
 				"api_key": schema.StringAttribute{
@@ -0,0 +1,93 @@
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// requiresUsersReplace replaces the resource when any of the users changes, the computed ids and
+// the passwords that are null after an import are ignored in the comparison
+func requiresUsersReplace() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			var stateUsers []AuthorizationUserBlockResourceModel
+			var planUsers []AuthorizationUserBlockResourceModel
+			if req.StateValue.IsUnknown() || req.PlanValue.IsUnknown() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &stateUsers, false)...)
+			resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planUsers, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if len(stateUsers) != len(planUsers) {
+				resp.RequiresReplace = true
+				return
+			}
+
+			for i := range stateUsers {
+				if userChanged(stateUsers[i], planUsers[i]) {
+					resp.RequiresReplace = true
+					return
+				}
+			}
+		},
+		"Requires replace if any of the users changes",
+		"Requires replace if any of the users changes",
+	)
+}
+
+// requiresNamesReplace replaces the resource when the user roles or claims change, only the names
+// are compared as the ids are computed
+func requiresNamesReplace() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			var current []AuthorizationUserGranularResourceModel
+			var planned []AuthorizationUserGranularResourceModel
+			if req.StateValue.IsUnknown() || req.PlanValue.IsUnknown() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &current, false)...)
+			resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planned, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.RequiresReplace = !sameNames(current, planned)
+		},
+		"Requires replace if the names change",
+		"Requires replace if the names change",
+	)
+}
+
+func userChanged(current, planned AuthorizationUserBlockResourceModel) bool {
+	if !current.Name.Equal(planned.Name) || !current.Username.Equal(planned.Username) || !current.Email.Equal(planned.Email) {
+		return true
+	}
+	if !current.Password.IsNull() && !current.Password.Equal(planned.Password) {
+		return true
+	}
+
+	return !sameNames(current.Roles, planned.Roles) || !sameNames(current.Claims, planned.Claims)
+}
+
+func sameNames(current, planned []AuthorizationUserGranularResourceModel) bool {
+	if len(current) != len(planned) {
+		return false
+	}
+	for i := range current {
+		if !strings.EqualFold(current[i].Name.ValueString(), planned[i].Name.ValueString()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -3,14 +3,16 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"terraform-provider-parallels-desktop/internal/apiclient"
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
 	"terraform-provider-parallels-desktop/internal/helpers"
 	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -75,6 +77,7 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	usersNotCreated := make([]string, 0)
@@ -287,65 +290,23 @@ func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	// hostConfig := apiclient.HostConfig{
-	// 	Host:          data.Host.ValueString(),
-	// 	License:       r.provider.License.ValueString(),
-	// 	Authorization: data.Authenticator,
-	// }
-
-	// for i, apiKey := range data.ApiKeys {
-	// 	existingApiKey, diag := apiclient.GetApiKey(ctx, hostConfig, apiKey.Key.ValueString())
-	// 	if diag.HasError() {
-	// 		resp.Diagnostics.Append(diag...)
-	// 		return
-	// 	}
-	// 	if existingApiKey == nil {
-	// 		resp.Diagnostics.AddError("api key not found", fmt.Sprintf("api key %s not found", apiKey.Name.ValueString()))
-	// 		return
-	// 	}
-	// 	data.ApiKeys[i].ApiKey = types.StringValue(helpers.Base64Encode(fmt.Sprintf("%s:%s", apiKey.Key.ValueString(), apiKey.Secret.ValueString())))
-	// }
-
-	// for _, user := range data.Users {
-	// 	currentUser, diag := apiclient.GetUser(ctx, hostConfig, user.Username.ValueString())
-	// 	if diag.HasError() {
-	// 		resp.Diagnostics.Append(diag...)
-	// 		return
-	// 	}
-	// 	if currentUser == nil {
-	// 		resp.Diagnostics.AddError("user not found", fmt.Sprintf("user %s not found", user.Name.ValueString()))
-	// 		return
-	// 	}
-	// }
-
-	// for _, role := range data.Roles {
-	// 	currentRole, diag := apiclient.GetRole(ctx, hostConfig, role.Name.ValueString())
-	// 	if diag.HasError() {
-	// 		resp.Diagnostics.Append(diag...)
-	// 		return
-	// 	}
-	// 	if currentRole == nil {
-	// 		resp.Diagnostics.AddError("role not found", fmt.Sprintf("role %s not found", role.Name.ValueString()))
-	// 		return
-	// 	}
-
-	// }
-
-	// if len(data.Claims) > 0 {
-	// 	for _, claim := range data.Claims {
-	// 		currentClaim, diag := apiclient.GetClaim(ctx, hostConfig, claim.Name.ValueString())
-	// 		if diag.HasError() {
-	// 			resp.Diagnostics.Append(diag...)
-	// 			return
-	// 		}
-	// 		if currentClaim == nil {
-	// 			resp.Diagnostics.AddError("claim not found", fmt.Sprintf("claim %s not found", claim.Name.ValueString()))
-	// 			return
-	// 		}
-	// 	}
-	// }
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+	}
 
-	resp.Diagnostics.Append(req.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(readClaims(ctx, hostConfig, &data)...)
+	resp.Diagnostics.Append(readRoles(ctx, hostConfig, &data)...)
+	resp.Diagnostics.Append(readApiKeys(ctx, hostConfig, &data)...)
+	resp.Diagnostics.Append(readUsers(ctx, hostConfig, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -373,6 +334,7 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	diag := updateClaims(ctx, hostConfig, &data, &currentData)
@@ -395,6 +357,9 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 		resp.Diagnostics.Append(diag...)
 	}
 
+	// the first update after an import has set the passwords and secrets we could not read back
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, nil)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -422,6 +387,7 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	for _, apiKey := range data.ApiKeys {
@@ -438,7 +404,12 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 		}
 	}
 
+	// the root user and its roles and claims are needed by the host, they are only removed from the state
+	system := getSystemIdentities(ctx, hostConfig)
 	for _, user := range data.Users {
+		if isRootUser(user.Username.ValueString()) {
+			continue
+		}
 		diag := apiclient.DeleteUser(ctx, hostConfig, user.Id.ValueString())
 		if diag.HasError() {
 			diag := apiclient.DeleteUser(ctx, hostConfig, user.Username.ValueString())
@@ -449,6 +420,9 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	for _, role := range data.Roles {
+		if system.isRole(role.Id.ValueString(), role.Name.ValueString()) {
+			continue
+		}
 		diag := apiclient.DeleteRole(ctx, hostConfig, role.Id.ValueString())
 		if diag.HasError() {
 			diag := apiclient.DeleteRole(ctx, hostConfig, role.Name.ValueString())
@@ -459,6 +433,9 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	for _, claim := range data.Claims {
+		if system.isClaim(claim.Id.ValueString(), claim.Name.ValueString()) {
+			continue
+		}
 		diag := apiclient.DeleteClaim(ctx, hostConfig, claim.Id.ValueString())
 		if diag.HasError() {
 			diag := apiclient.DeleteClaim(ctx, hostConfig, claim.Name.ValueString())
@@ -476,16 +453,51 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// ImportState sets the host, credentials and the identities to manage from the import id in the
+// format [username:password@]host@identities, the Read then rebuilds the rest of the state.
+// Passwords and api key secrets cannot be read back and are taken from the configuration
 func (r *AuthorizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	host, auth, selector, err := common.ParseImportId(req.ID, "identities", "identities to import")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	data := AuthorizationResourceModel{
+		Authenticator: auth,
+		Host:          types.StringValue(host),
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+	}
+
+	resp.Diagnostics.Append(importIdentities(ctx, hostConfig, selector, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, planmodifiers.ImportedPrivateStateValue)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func updateClaims(ctx context.Context, hostConfig apiclient.HostConfig, data, currentData *AuthorizationResourceModel) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
+	system := systemIdentities{}
+	if len(currentData.Claims) > 0 {
+		system = getSystemIdentities(ctx, hostConfig)
+	}
 
 	// If there is not any claim we delete all of them
 	if len(data.Claims) == 0 {
 		for _, claim := range currentData.Claims {
+			if system.isClaim(claim.Id.ValueString(), claim.Name.ValueString()) {
+				continue
+			}
 			diag := apiclient.DeleteClaim(ctx, hostConfig, claim.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -504,7 +516,7 @@ func updateClaims(ctx context.Context, hostConfig apiclient.HostConfig, data, cu
 			}
 		}
 
-		if !found {
+		if !found && !system.isClaim(claim.Id.ValueString(), claim.Name.ValueString()) {
 			diag := apiclient.DeleteClaim(ctx, hostConfig, claim.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -555,9 +567,17 @@ func updateClaims(ctx context.Context, hostConfig apiclient.HostConfig, data, cu
 
 func updateRoles(ctx context.Context, hostConfig apiclient.HostConfig, data, currentData *AuthorizationResourceModel) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
+	system := systemIdentities{}
+	if len(currentData.Roles) > 0 {
+		system = getSystemIdentities(ctx, hostConfig)
+	}
+
 	// If there is not any claim we delete all of them
 	if len(data.Roles) == 0 {
 		for _, role := range currentData.Roles {
+			if system.isRole(role.Id.ValueString(), role.Name.ValueString()) {
+				continue
+			}
 			diag := apiclient.DeleteRole(ctx, hostConfig, role.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -576,7 +596,7 @@ func updateRoles(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 			}
 		}
 
-		if !found {
+		if !found && !system.isRole(role.Id.ValueString(), role.Name.ValueString()) {
 			diag := apiclient.DeleteRole(ctx, hostConfig, role.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -713,6 +733,9 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 	// If there is not any users, we delete all of them
 	if len(data.Users) == 0 {
 		for _, user := range currentData.Users {
+			if isRootUser(user.Username.ValueString()) {
+				continue
+			}
 			diag := apiclient.DeleteUser(ctx, hostConfig, user.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -732,7 +755,7 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 			}
 		}
 
-		if !found {
+		if !found && !isRootUser(user.Username.ValueString()) {
 			diag := apiclient.DeleteUser(ctx, hostConfig, user.Id.ValueString())
 			if diag.HasError() {
 				diagnostics.Append(diag...)
@@ -767,6 +790,7 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 				continue
 			} else {
 				tflog.Info(ctx, fmt.Sprintf("User %s created", user.Username.ValueString()))
+				data.Users[i].Id = types.StringValue(response.ID)
 				if diag := updateUserClaims(ctx, hostConfig, data, currentData, i); diag.HasError() {
 					diagnostics.Append(diag...)
 					continue
@@ -775,7 +799,6 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 					diagnostics.Append(diag...)
 					continue
 				}
-				data.Users[i].Id = types.StringValue(response.ID)
 			}
 		} else {
 			tflog.Info(ctx, fmt.Sprintf("User %s already exists", user.Username.ValueString()))
@@ -789,6 +812,7 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 				continue
 			}
 			tflog.Info(ctx, fmt.Sprintf("User %s found, updating id %v", userExists.Name, userExists.ID))
+			data.Users[i].Id = types.StringValue(userExists.ID)
 			if diag := updateUserClaims(ctx, hostConfig, data, currentData, i); diag.HasError() {
 				diagnostics.Append(diag...)
 				continue
@@ -797,7 +821,6 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 				diagnostics.Append(diag...)
 				continue
 			}
-			data.Users[i].Id = types.StringValue(userExists.ID)
 		}
 	}
 
@@ -806,13 +829,14 @@ func updateUsers(ctx context.Context, hostConfig apiclient.HostConfig, data, cur
 
 func updateUserRoles(ctx context.Context, hostConfig apiclient.HostConfig, data, currentData *AuthorizationResourceModel, userIndex int) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
+	currentRoles := getCurrentUser(data, currentData, userIndex).Roles
 
-	// We will now check if there is any user like that in the current state,
-	// if not we will create it otherwise we will just update the id
+	// We will add any role the user does not have yet in the current state,
+	// otherwise we will just update the id
 	for i, role := range data.Users[userIndex].Roles {
 		found := false
-		for _, currentUserRole := range currentData.Users[userIndex].Roles {
-			if role == currentUserRole {
+		for _, currentUserRole := range currentRoles {
+			if strings.EqualFold(role.Name.ValueString(), currentUserRole.Name.ValueString()) {
 				found = true
 				break
 			}
@@ -821,14 +845,10 @@ func updateUserRoles(ctx context.Context, hostConfig apiclient.HostConfig, data,
 		if !found {
 			roleResponse, diag := apiclient.AddRoleToUser(ctx, hostConfig, data.Users[userIndex].Id.ValueString(), role.Name.ValueString())
 			if diag.HasError() {
-				diag := apiclient.DeleteUser(ctx, hostConfig, data.Users[i].Id.ValueString())
-				if diag.HasError() {
-					diagnostics.Append(diag...)
-					continue
-				}
-			} else {
-				data.Users[userIndex].Roles[i].Id = types.StringValue(roleResponse.ID)
+				diagnostics.Append(diag...)
+				continue
 			}
+			data.Users[userIndex].Roles[i].Id = types.StringValue(roleResponse.ID)
 		} else {
 			roleExists, diag := apiclient.GetRole(ctx, hostConfig, role.Name.ValueString())
 			if diag.HasError() {
@@ -848,13 +868,14 @@ func updateUserRoles(ctx context.Context, hostConfig apiclient.HostConfig, data,
 
 func updateUserClaims(ctx context.Context, hostConfig apiclient.HostConfig, data, currentData *AuthorizationResourceModel, userIndex int) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
+	currentClaims := getCurrentUser(data, currentData, userIndex).Claims
 
-	// We will now check if there is any user like that in the current state,
-	// if not we will create it otherwise we will just update the id
+	// We will add any claim the user does not have yet in the current state,
+	// otherwise we will just update the id
 	for i, claim := range data.Users[userIndex].Claims {
 		found := false
-		for _, currentUserClaim := range currentData.Users[userIndex].Claims {
-			if claim == currentUserClaim {
+		for _, currentUserClaim := range currentClaims {
+			if strings.EqualFold(claim.Name.ValueString(), currentUserClaim.Name.ValueString()) {
 				found = true
 				break
 			}
@@ -863,14 +884,10 @@ func updateUserClaims(ctx context.Context, hostConfig apiclient.HostConfig, data
 		if !found {
 			claimResponse, diag := apiclient.AddClaimToUser(ctx, hostConfig, data.Users[userIndex].Id.ValueString(), claim.Name.ValueString())
 			if diag.HasError() {
-				diag := apiclient.DeleteUser(ctx, hostConfig, data.Users[i].Id.ValueString())
-				if diag.HasError() {
-					diagnostics.Append(diag...)
-					continue
-				}
-			} else {
-				data.Users[userIndex].Claims[i].Id = types.StringValue(claimResponse.ID)
+				diagnostics.Append(diag...)
+				continue
 			}
+			data.Users[userIndex].Claims[i].Id = types.StringValue(claimResponse.ID)
 		} else {
 			claimExists, diag := apiclient.GetClaim(ctx, hostConfig, claim.Name.ValueString())
 			if diag.HasError() {
@@ -887,3 +904,15 @@ func updateUserClaims(ctx context.Context, hostConfig apiclient.HostConfig, data
 
 	return diagnostics
 }
+
+// getCurrentUser returns the user in the current state with the same username, an empty user is
+// returned for new users so all their roles and claims are added
+func getCurrentUser(data, currentData *AuthorizationResourceModel, userIndex int) AuthorizationUserBlockResourceModel {
+	for _, currentUser := range currentData.Users {
+		if currentUser.Username.ValueString() == data.Users[userIndex].Username.ValueString() {
+			return *currentUser
+		}
+	}
+
+	return AuthorizationUserBlockResourceModel{}
+}
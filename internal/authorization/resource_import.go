@@ -0,0 +1,126 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const importAllIdentities = "*"
+
+// importIdentities adds the identities in the selector to the state, the selector is either * for
+// every user, role, claim and api key in the host or a comma separated list of user:<username>,
+// role:<name>, claim:<name> and api_key:<name>. The * skips the root user and its system roles and
+// claims. Only the names are set here, the Read fills the rest
+func importIdentities(ctx context.Context, hostConfig apiclient.HostConfig, selector string, data *AuthorizationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if strings.TrimSpace(selector) == importAllIdentities {
+		users, diag := apiclient.GetUsers(ctx, hostConfig, "", "")
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		var system systemIdentities
+		for _, user := range users {
+			if isRootUser(user.Username) {
+				system = newSystemIdentities(&user)
+				continue
+			}
+			data.Users = append(data.Users, newImportedUser(user.Username))
+		}
+
+		roles, diag := apiclient.GetRoles(ctx, hostConfig, "", "")
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		for _, role := range roles {
+			if system.isRole(role.ID, role.Name) {
+				continue
+			}
+			importedRole := newImportedClaimOrRole(role.Name)
+			data.Roles = append(data.Roles, &importedRole)
+		}
+
+		claims, diag := apiclient.GetClaims(ctx, hostConfig, "", "")
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		for _, claim := range claims {
+			if system.isClaim(claim.ID, claim.Name) {
+				continue
+			}
+			data.Claims = append(data.Claims, newImportedClaimOrRole(claim.Name))
+		}
+
+		apiKeys, diag := apiclient.GetApiKeys(ctx, hostConfig, "", "")
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		for _, apiKey := range apiKeys {
+			data.ApiKeys = append(data.ApiKeys, newImportedApiKey(apiKey.Name))
+		}
+
+		return diagnostics
+	}
+
+	for _, item := range strings.Split(selector, ",") {
+		kind, name, found := strings.Cut(strings.TrimSpace(item), ":")
+		if !found || name == "" {
+			diagnostics.AddError("Invalid import id", fmt.Sprintf("invalid identity %q, expected * or a comma separated list of user:<username>, role:<name>, claim:<name> and api_key:<name>", item))
+			return diagnostics
+		}
+
+		switch strings.ToLower(kind) {
+		case UserSchemaBlockName:
+			data.Users = append(data.Users, newImportedUser(name))
+		case RoleSchemaBlockName:
+			importedRole := newImportedClaimOrRole(name)
+			data.Roles = append(data.Roles, &importedRole)
+		case ClaimSchemaBlockName:
+			data.Claims = append(data.Claims, newImportedClaimOrRole(name))
+		case ApiKeySchemaBlockName:
+			data.ApiKeys = append(data.ApiKeys, newImportedApiKey(name))
+		default:
+			diagnostics.AddError("Invalid import id", fmt.Sprintf("unknown identity type %q, expected user, role, claim or api_key", kind))
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+func newImportedUser(username string) *AuthorizationUserBlockResourceModel {
+	return &AuthorizationUserBlockResourceModel{
+		Id:       types.StringNull(),
+		Name:     types.StringNull(),
+		Username: types.StringValue(username),
+		Email:    types.StringNull(),
+		Password: types.StringNull(),
+	}
+}
+
+func newImportedClaimOrRole(name string) AuthorizationUserGranularResourceModel {
+	return AuthorizationUserGranularResourceModel{
+		Id:   types.StringNull(),
+		Name: types.StringValue(name),
+	}
+}
+
+func newImportedApiKey(name string) *AuthorizationApiKeysResourceModel {
+	return &AuthorizationApiKeysResourceModel{
+		Id:     types.StringNull(),
+		Name:   types.StringValue(name),
+		Key:    types.StringNull(),
+		Secret: types.StringNull(),
+		ApiKey: types.StringNull(),
+	}
+}
@@ -0,0 +1,251 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/helpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type getClaimOrRoleFunc func(ctx context.Context, config apiclient.HostConfig, id string) (*apimodels.ClaimRoleResponse, diag.Diagnostics)
+
+// readClaims refreshes the claim ids and removes the claims that were deleted from the host so
+// they are created again on the next apply
+func readClaims(ctx context.Context, hostConfig apiclient.HostConfig, data *AuthorizationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if data.Claims == nil {
+		return diagnostics
+	}
+
+	claims := make([]AuthorizationUserGranularResourceModel, 0, len(data.Claims))
+	for _, claim := range data.Claims {
+		currentClaim, diag := findClaimOrRole(ctx, hostConfig, apiclient.GetClaim, claim.Id, claim.Name)
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		if currentClaim == nil {
+			tflog.Info(ctx, fmt.Sprintf("Claim %s not found, removing it from the state", claim.Name.ValueString()))
+			continue
+		}
+
+		claim.Id = types.StringValue(currentClaim.ID)
+		if claim.Name.IsNull() {
+			claim.Name = types.StringValue(currentClaim.Name)
+		}
+		claims = append(claims, claim)
+	}
+
+	data.Claims = claims
+	return diagnostics
+}
+
+// readRoles refreshes the role ids and removes the roles that were deleted from the host so
+// they are created again on the next apply
+func readRoles(ctx context.Context, hostConfig apiclient.HostConfig, data *AuthorizationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if data.Roles == nil {
+		return diagnostics
+	}
+
+	roles := make([]*AuthorizationUserGranularResourceModel, 0, len(data.Roles))
+	for _, role := range data.Roles {
+		currentRole, diag := findClaimOrRole(ctx, hostConfig, apiclient.GetRole, role.Id, role.Name)
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+		if currentRole == nil {
+			tflog.Info(ctx, fmt.Sprintf("Role %s not found, removing it from the state", role.Name.ValueString()))
+			continue
+		}
+
+		role.Id = types.StringValue(currentRole.ID)
+		if role.Name.IsNull() {
+			role.Name = types.StringValue(currentRole.Name)
+		}
+		roles = append(roles, role)
+	}
+
+	data.Roles = roles
+	return diagnostics
+}
+
+// readApiKeys refreshes the api key ids and removes the api keys that were deleted from the host,
+// the secret cannot be read back so the composite key is only set when the secret is known
+func readApiKeys(ctx context.Context, hostConfig apiclient.HostConfig, data *AuthorizationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if data.ApiKeys == nil {
+		return diagnostics
+	}
+
+	apiKeys := make([]*AuthorizationApiKeysResourceModel, 0, len(data.ApiKeys))
+	for _, apiKey := range data.ApiKeys {
+		var currentApiKey *apimodels.ApiKeyResponse
+		if apiKey.Id.ValueString() != "" {
+			response, diag := apiclient.GetApiKey(ctx, hostConfig, apiKey.Id.ValueString())
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			currentApiKey = response
+		}
+		if currentApiKey == nil {
+			response, diag := apiclient.GetApiKey(ctx, hostConfig, apiKey.Name.ValueString())
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			currentApiKey = response
+		}
+		if currentApiKey == nil {
+			tflog.Info(ctx, fmt.Sprintf("Api Key %s not found, removing it from the state", apiKey.Name.ValueString()))
+			continue
+		}
+
+		apiKey.Id = types.StringValue(currentApiKey.ID)
+		if apiKey.Key.IsNull() && currentApiKey.Key != "" {
+			apiKey.Key = types.StringValue(currentApiKey.Key)
+		}
+		if !apiKey.Key.IsNull() && !apiKey.Secret.IsNull() {
+			apiKey.ApiKey = types.StringValue(helpers.Base64Encode(fmt.Sprintf("%s:%s", apiKey.Key.ValueString(), apiKey.Secret.ValueString())))
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	data.ApiKeys = apiKeys
+	return diagnostics
+}
+
+// readUsers refreshes the users details from the host and removes the users that were deleted,
+// roles and claims removed from a user are dropped from the state while the ones assigned outside
+// of terraform are ignored as the host also assigns defaults. Users without an id were imported and
+// get all their roles and claims from the host
+func readUsers(ctx context.Context, hostConfig apiclient.HostConfig, data *AuthorizationResourceModel) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if data.Users == nil {
+		return diagnostics
+	}
+
+	users := make([]*AuthorizationUserBlockResourceModel, 0, len(data.Users))
+	for _, user := range data.Users {
+		var currentUser *apimodels.UserResponse
+		if user.Id.ValueString() != "" {
+			response, diag := apiclient.GetUser(ctx, hostConfig, user.Id.ValueString())
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			currentUser = response
+		}
+		if currentUser == nil {
+			response, diag := apiclient.GetUser(ctx, hostConfig, user.Username.ValueString())
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			currentUser = response
+		}
+		if currentUser == nil {
+			tflog.Info(ctx, fmt.Sprintf("User %s not found, removing it from the state", user.Username.ValueString()))
+			continue
+		}
+
+		if user.Id.IsNull() {
+			roles, diag := importUserMembership(ctx, hostConfig, apiclient.GetRole, currentUser.Roles)
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			claims, diag := importUserMembership(ctx, hostConfig, apiclient.GetClaim, currentUser.Claims)
+			if diag.HasError() {
+				diagnostics.Append(diag...)
+				return diagnostics
+			}
+			user.Roles = roles
+			user.Claims = claims
+		} else {
+			user.Roles = readUserMembership(ctx, user.Username.ValueString(), user.Roles, currentUser.Roles)
+			user.Claims = readUserMembership(ctx, user.Username.ValueString(), user.Claims, currentUser.Claims)
+		}
+
+		user.Id = types.StringValue(currentUser.ID)
+		user.Username = types.StringValue(currentUser.Username)
+		user.Name = types.StringValue(currentUser.Name)
+		user.Email = types.StringValue(currentUser.Email)
+		users = append(users, user)
+	}
+
+	data.Users = users
+	return diagnostics
+}
+
+// findClaimOrRole looks up a claim or role by its id falling back to the name, the host accepts
+// both and the name survives the claim or role being recreated outside of terraform
+func findClaimOrRole(ctx context.Context, hostConfig apiclient.HostConfig, get getClaimOrRoleFunc, id, name types.String) (*apimodels.ClaimRoleResponse, diag.Diagnostics) {
+	if id.ValueString() != "" {
+		response, diag := get(ctx, hostConfig, id.ValueString())
+		if diag.HasError() || response != nil {
+			return response, diag
+		}
+	}
+
+	return get(ctx, hostConfig, name.ValueString())
+}
+
+func readUserMembership(ctx context.Context, username string, current []AuthorizationUserGranularResourceModel, assigned []string) []AuthorizationUserGranularResourceModel {
+	if current == nil {
+		return nil
+	}
+
+	result := make([]AuthorizationUserGranularResourceModel, 0, len(current))
+	for _, item := range current {
+		found := false
+		for _, name := range assigned {
+			if strings.EqualFold(item.Name.ValueString(), name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tflog.Info(ctx, fmt.Sprintf("%s is no longer assigned to user %s, removing it from the state", item.Name.ValueString(), username))
+			continue
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func importUserMembership(ctx context.Context, hostConfig apiclient.HostConfig, get getClaimOrRoleFunc, assigned []string) ([]AuthorizationUserGranularResourceModel, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	if len(assigned) == 0 {
+		return nil, diagnostics
+	}
+
+	result := make([]AuthorizationUserGranularResourceModel, 0, len(assigned))
+	for _, name := range assigned {
+		item := AuthorizationUserGranularResourceModel{
+			Id:   types.StringNull(),
+			Name: types.StringValue(name),
+		}
+		response, diag := get(ctx, hostConfig, name)
+		if diag.HasError() {
+			diagnostics.Append(diag...)
+			return nil, diagnostics
+		}
+		if response != nil {
+			item.Id = types.StringValue(response.ID)
+		}
+		result = append(result, item)
+	}
+
+	return result, diagnostics
+}
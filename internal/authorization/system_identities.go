@@ -0,0 +1,67 @@
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/constants"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// systemIdentities are the root user and the roles and claims the host gives it when it is set up,
+// they are not imported with * and the resource never deletes them as the host needs them
+type systemIdentities struct {
+	roles  []string
+	claims []string
+}
+
+// newSystemIdentities returns the system identities from the root user of the host
+func newSystemIdentities(rootUser *apimodels.UserResponse) systemIdentities {
+	if rootUser == nil {
+		return systemIdentities{}
+	}
+
+	return systemIdentities{
+		roles:  rootUser.Roles,
+		claims: rootUser.Claims,
+	}
+}
+
+// getSystemIdentities reads the root user of the host, if it cannot be read only the root user
+// itself is protected
+func getSystemIdentities(ctx context.Context, hostConfig apiclient.HostConfig) systemIdentities {
+	rootUser, diag := apiclient.GetUser(ctx, hostConfig, constants.RootUser)
+	if diag.HasError() {
+		tflog.Info(ctx, "Could not read the root user, only the root user is protected from being deleted")
+		return systemIdentities{}
+	}
+
+	return newSystemIdentities(rootUser)
+}
+
+func isRootUser(username string) bool {
+	return strings.EqualFold(strings.TrimSpace(username), constants.RootUser)
+}
+
+func (s systemIdentities) isRole(id string, name string) bool {
+	return containsIdentity(s.roles, id, name)
+}
+
+func (s systemIdentities) isClaim(id string, name string) bool {
+	return containsIdentity(s.claims, id, name)
+}
+
+// containsIdentity returns true if the list has the identity by id or name, the user roles and
+// claims can be returned by either of them
+func containsIdentity(identities []string, id string, name string) bool {
+	for _, identity := range identities {
+		if (id != "" && strings.EqualFold(identity, id)) || (name != "" && strings.EqualFold(identity, name)) {
+			return true
+		}
+	}
+
+	return false
+}
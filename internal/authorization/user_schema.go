@@ -1,8 +1,9 @@
 package authorization
 
 import (
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
@@ -12,7 +13,7 @@ var (
 	UserSchemaBlock     = schema.ListNestedBlock{
 		MarkdownDescription: "Creates a new user in the Parallels Desktop API",
 		PlanModifiers: []planmodifier.List{
-			listplanmodifier.RequiresReplace(),
+			requiresUsersReplace(),
 		},
 		NestedObject: schema.NestedBlockObject{
 			Attributes: map[string]schema.Attribute{
@@ -46,14 +47,14 @@ var (
 					Required:            true,
 					Sensitive:           true,
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						planmodifiers.StringRequiresReplaceUnlessImported(),
 					},
 				},
 				"roles": schema.ListNestedAttribute{
 					MarkdownDescription: "User roles",
 					Optional:            true,
 					PlanModifiers: []planmodifier.List{
-						listplanmodifier.RequiresReplace(),
+						requiresNamesReplace(),
 					},
 					NestedObject: schema.NestedAttributeObject{
 						Attributes: map[string]schema.Attribute{
@@ -72,7 +73,7 @@ var (
 					MarkdownDescription: "User claims",
 					Optional:            true,
 					PlanModifiers: []planmodifier.List{
-						listplanmodifier.RequiresReplace(),
+						requiresNamesReplace(),
 					},
 					NestedObject: schema.NestedAttributeObject{
 						Attributes: map[string]schema.Attribute{
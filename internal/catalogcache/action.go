@@ -0,0 +1,128 @@
+package catalogcache
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	action_models "terraform-provider-parallels-desktop/internal/catalogcache/models"
+	"terraform-provider-parallels-desktop/internal/catalogcache/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+var (
+	_ action.Action              = &CatalogCacheEvictAction{}
+	_ action.ActionWithConfigure = &CatalogCacheEvictAction{}
+)
+
+func NewCatalogCacheEvictAction() action.Action {
+	return &CatalogCacheEvictAction{}
+}
+
+// CatalogCacheEvictAction evicts cached catalog images on demand, without having to manage
+// them with the catalog_cache resource
+type CatalogCacheEvictAction struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (a *CatalogCacheEvictAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.provider = data
+}
+
+func (a *CatalogCacheEvictAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_cache_evict"
+}
+
+func (a *CatalogCacheEvictAction) Schema(_ context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schemas.CatalogCacheEvictActionSchemaV0
+}
+
+func (a *CatalogCacheEvictAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data action_models.CatalogCacheEvictActionModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		a.provider.License.String(),
+		telemetry.EventCatalogCache, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              a.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: a.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            a.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	cacheHosts, diag := common.GetCatalogCacheHosts(ctx, hostConfig, common.GetStrings(data.HostIds), "")
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	target := "the whole cache"
+	if data.CatalogId.ValueString() != "" {
+		target = "catalog " + data.CatalogId.ValueString()
+		if data.Version.ValueString() != "" {
+			target += " version " + data.Version.ValueString()
+		}
+	}
+
+	for _, cacheHost := range cacheHosts {
+		hostName := cacheHost.Host
+		if cacheHost.HostId != "" {
+			hostName = cacheHost.HostId
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Evicting " + target + " from host " + hostName,
+		})
+		if evictDiag := apiclient.DeleteCatalogCache(ctx, cacheHost, data.CatalogId.ValueString(), data.Version.ValueString()); evictDiag.HasError() {
+			resp.Diagnostics.Append(evictDiag...)
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package catalogcache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	data_models "terraform-provider-parallels-desktop/internal/catalogcache/models"
+	"terraform-provider-parallels-desktop/internal/catalogcache/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &CatalogCacheDataSource{}
+	_ datasource.DataSourceWithConfigure = &CatalogCacheDataSource{}
+)
+
+func NewCatalogCacheDataSource() datasource.DataSource {
+	return &CatalogCacheDataSource{}
+}
+
+type CatalogCacheDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *CatalogCacheDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *CatalogCacheDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_cache"
+}
+
+func (d *CatalogCacheDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.CatalogCacheDataSourceSchemaV0
+}
+
+func (d *CatalogCacheDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.CatalogCacheDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	cacheHosts, diag := common.GetCatalogCacheHosts(ctx, hostConfig, common.GetStrings(data.HostIds), "")
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	totalSize := int64(0)
+	data.Items = make([]data_models.CatalogCacheItemModelV0, 0)
+	for _, cacheHost := range cacheHosts {
+		cache, diag := apiclient.GetCatalogCache(ctx, cacheHost)
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+
+		for _, item := range cache.Manifests {
+			if data.CatalogId.ValueString() != "" && !strings.EqualFold(item.CatalogId, data.CatalogId.ValueString()) {
+				continue
+			}
+
+			totalSize += item.CacheSize
+			data.Items = append(data.Items, data_models.NewCatalogCacheItemModelV0(cacheHost.HostId, item))
+		}
+	}
+	data.TotalSize = types.Int64Value(totalSize)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
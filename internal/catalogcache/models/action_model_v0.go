@@ -0,0 +1,19 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogCacheEvictActionModelV0 describes the catalog_cache_evict action data model.
+type CatalogCacheEvictActionModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	Orchestrator  types.String                  `tfsdk:"orchestrator"`
+	HostIds       []types.String                `tfsdk:"host_ids"`
+	CatalogId     types.String                  `tfsdk:"catalog_id"`
+	Version       types.String                  `tfsdk:"version"`
+}
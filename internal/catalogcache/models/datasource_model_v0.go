@@ -0,0 +1,49 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogCacheDataSourceModelV0 represents the data source schema for the catalog_cache data source.
+type CatalogCacheDataSourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	Orchestrator  types.String                  `tfsdk:"orchestrator"`
+	HostIds       []types.String                `tfsdk:"host_ids"`
+	CatalogId     types.String                  `tfsdk:"catalog_id"`
+	TotalSize     types.Int64                   `tfsdk:"total_size"`
+	Items         []CatalogCacheItemModelV0     `tfsdk:"items"`
+}
+
+// CatalogCacheItemModelV0 represents a catalog image cached in a host.
+type CatalogCacheItemModelV0 struct {
+	ID           types.String `tfsdk:"id"`           // The unique identifier of the cached catalog manifest.
+	HostId       types.String `tfsdk:"host_id"`      // The orchestrator host the image is cached in.
+	CatalogId    types.String `tfsdk:"catalog_id"`   // The catalog id of the cached image.
+	Version      types.String `tfsdk:"version"`      // The version of the cached image.
+	Architecture types.String `tfsdk:"architecture"` // The architecture of the cached image.
+	Type         types.String `tfsdk:"type"`         // The type of cache, either a packed or an unpacked image.
+	Size         types.Int64  `tfsdk:"size"`         // The size the image takes in the cache.
+	CachedAt     types.String `tfsdk:"cached_at"`    // When the image was cached.
+	LastUsedAt   types.String `tfsdk:"last_used_at"` // When the cached image was last used to create a machine.
+}
+
+// NewCatalogCacheItemModelV0 creates the data source model from a cached item
+func NewCatalogCacheItemModelV0(hostId string, item apimodels.CatalogCacheItem) CatalogCacheItemModelV0 {
+	return CatalogCacheItemModelV0{
+		ID:           types.StringValue(item.ID),
+		HostId:       types.StringValue(hostId),
+		CatalogId:    types.StringValue(item.CatalogId),
+		Version:      types.StringValue(item.Version),
+		Architecture: types.StringValue(item.Architecture),
+		Type:         types.StringValue(item.CacheType),
+		Size:         types.Int64Value(item.CacheSize),
+		CachedAt:     types.StringValue(item.CacheDate),
+		LastUsedAt:   types.StringValue(item.CacheLastUsed),
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogCacheResourceModelV0 describes the resource data model.
+type CatalogCacheResourceModelV0 struct {
+	Authenticator     *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel         *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host              types.String                  `tfsdk:"host"`
+	Orchestrator      types.String                  `tfsdk:"orchestrator"`
+	ID                types.String                  `tfsdk:"id"`
+	CatalogConnection types.String                  `tfsdk:"catalog_connection"`
+	CatalogId         types.String                  `tfsdk:"catalog_id"`
+	Version           types.String                  `tfsdk:"version"`
+	Architecture      types.String                  `tfsdk:"architecture"`
+	HostIds           []types.String                `tfsdk:"host_ids"`
+	CachedHostIds     types.List                    `tfsdk:"cached_host_ids"`
+	Size              types.Int64                   `tfsdk:"size"`
+	EvictOnDestroy    types.Bool                    `tfsdk:"evict_on_destroy"`
+	Timeouts          timeouts.Value                `tfsdk:"timeouts"`
+}
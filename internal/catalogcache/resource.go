@@ -0,0 +1,599 @@
+package catalogcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	resource_models "terraform-provider-parallels-desktop/internal/catalogcache/models"
+	"terraform-provider-parallels-desktop/internal/catalogcache/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &CatalogCacheResource{}
+	_ resource.ResourceWithModifyPlan = &CatalogCacheResource{}
+)
+
+// evictedHostsPrivateStateKey keeps the orchestrator hosts that need to be warmed again
+const evictedHostsPrivateStateKey = "evicted_hosts"
+
+func NewCatalogCacheResource() resource.Resource {
+	return &CatalogCacheResource{}
+}
+
+// CatalogCacheResource defines the resource implementation.
+type CatalogCacheResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *CatalogCacheResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_cache"
+}
+
+func (r *CatalogCacheResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetCatalogCacheSchemaV0(ctx)
+}
+
+func (r *CatalogCacheResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *CatalogCacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.CatalogCacheResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogCache, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout, warming several hosts with big images can take a while
+	createTimeout, diags := data.Timeouts.Create(ctx, 120*time.Minute)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
+		return
+	}
+
+	cacheHosts, hostsDiag := common.GetCatalogCacheHosts(ctx, hostConfig, common.GetStrings(data.HostIds), data.Architecture.ValueString())
+	if hostsDiag.HasError() {
+		resp.Diagnostics.Append(hostsDiag...)
+		return
+	}
+	if len(cacheHosts) == 0 {
+		resp.Diagnostics.AddError("No hosts to warm", "Could not find any enabled orchestrator host to cache the catalog "+data.CatalogId.ValueString()+" in")
+		return
+	}
+
+	cacheRequest := apimodels.CacheCatalogRequest{
+		CatalogId:    data.CatalogId.ValueString(),
+		Version:      data.Version.ValueString(),
+		Architecture: data.Architecture.ValueString(),
+		Connection:   catalogConnection,
+	}
+
+	warmedHostIds := make([]string, 0)
+
+	// saving the hosts already warmed when one of them fails so they are evicted on destroy, the
+	// id and size are only known once the cached item was found in one of them
+	saveWarmedHosts := func() {
+		if len(warmedHostIds) == 0 {
+			return
+		}
+		if data.ID.IsUnknown() {
+			data.ID = types.StringNull()
+		}
+		if data.Size.IsUnknown() {
+			data.Size = types.Int64Null()
+		}
+		resp.Diagnostics.Append(setCachedHostIds(ctx, &data, warmedHostIds)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	for _, cacheHost := range cacheHosts {
+		item, cacheDiag := warmHost(ctx, cacheHost, cacheRequest, &warmedHostIds)
+		if cacheDiag.HasError() {
+			resp.Diagnostics.Append(cacheDiag...)
+			saveWarmedHosts()
+			return
+		}
+
+		data.ID = types.StringValue(item.ID)
+		data.Size = types.Int64Value(item.CacheSize)
+	}
+
+	resp.Diagnostics.Append(setCachedHostIds(ctx, &data, warmedHostIds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogCacheResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.CatalogCacheResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogCache, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	cacheHosts := []apiclient.HostConfig{hostConfig}
+	if isOrchestrator {
+		stateHostIds, diags := getCachedHostIds(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		cacheHosts = make([]apiclient.HostConfig, 0)
+		for _, hostId := range stateHostIds {
+			config := hostConfig
+			config.HostId = hostId
+			cacheHosts = append(cacheHosts, config)
+		}
+	}
+
+	evictedHostIds, diags := getEvictedHostIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cachedHostIds := make([]string, 0)
+	for _, cacheHost := range cacheHosts {
+		cache, cacheDiag := apiclient.GetCatalogCache(ctx, cacheHost)
+		if cacheDiag.HasError() {
+			resp.Diagnostics.Append(cacheDiag...)
+			return
+		}
+
+		// the image was evicted outside terraform, only this host needs to be warmed again
+		item := findCatalogCacheItem(cache, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString())
+		if item == nil {
+			tflog.Info(ctx, "Catalog "+data.CatalogId.ValueString()+" version "+data.Version.ValueString()+" is no longer cached in host "+cacheHost.HostId)
+			if !isOrchestrator {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			evictedHostIds = append(evictedHostIds, cacheHost.HostId)
+			continue
+		}
+
+		if isOrchestrator {
+			cachedHostIds = append(cachedHostIds, cacheHost.HostId)
+		}
+		data.ID = types.StringValue(item.ID)
+		data.Size = types.Int64Value(item.CacheSize)
+	}
+
+	if isOrchestrator {
+		// no host has the image cached anymore, the resource needs to be created again
+		if len(cachedHostIds) == 0 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(setCachedHostIds(ctx, &data, cachedHostIds)...)
+		resp.Diagnostics.Append(setEvictedHostIds(ctx, resp.Private, evictedHostIds)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogCacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.CatalogCacheResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogCache, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	// every other cached value requires warming the hosts again, only the destroy behavior and the
+	// hosts that evicted the image outside terraform change in place
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var currentData resource_models.CatalogCacheResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = currentData.ID
+	data.Size = currentData.Size
+	data.CachedHostIds = currentData.CachedHostIds
+
+	evictedHostIds, diags := getEvictedHostIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(evictedHostIds) == 0 || data.Orchestrator.ValueString() == "" {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Setting the default timeout, warming several hosts with big images can take a while
+	updateTimeout, diags := data.Timeouts.Update(ctx, 120*time.Minute)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Orchestrator.ValueString(),
+		IsOrchestrator:       true,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
+		return
+	}
+
+	cacheRequest := apimodels.CacheCatalogRequest{
+		CatalogId:    data.CatalogId.ValueString(),
+		Version:      data.Version.ValueString(),
+		Architecture: data.Architecture.ValueString(),
+		Connection:   catalogConnection,
+	}
+
+	warmedHostIds, diags := getCachedHostIds(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// saving the hosts already warmed again when one of them fails, the others are retried on the next apply
+	for i, hostId := range evictedHostIds {
+		cacheHost := hostConfig
+		cacheHost.HostId = hostId
+		item, cacheDiag := warmHost(ctx, cacheHost, cacheRequest, &warmedHostIds)
+		if cacheDiag.HasError() {
+			resp.Diagnostics.Append(cacheDiag...)
+			resp.Diagnostics.Append(setCachedHostIds(ctx, &data, warmedHostIds)...)
+			resp.Diagnostics.Append(setEvictedHostIds(ctx, resp.Private, evictedHostIds[i:])...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		data.ID = types.StringValue(item.ID)
+		data.Size = types.Int64Value(item.CacheSize)
+	}
+
+	resp.Diagnostics.Append(setCachedHostIds(ctx, &data, warmedHostIds)...)
+	resp.Diagnostics.Append(setEvictedHostIds(ctx, resp.Private, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogCacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.CatalogCacheResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogCache, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.EvictOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Keeping catalog "+data.CatalogId.ValueString()+" version "+data.Version.ValueString()+" in the hosts cache")
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	cacheHosts := []apiclient.HostConfig{hostConfig}
+	if isOrchestrator {
+		stateHostIds, diags := getCachedHostIds(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		cacheHosts = make([]apiclient.HostConfig, 0)
+		for _, hostId := range stateHostIds {
+			config := hostConfig
+			config.HostId = hostId
+			cacheHosts = append(cacheHosts, config)
+		}
+	}
+
+	for _, cacheHost := range cacheHosts {
+		if evictDiag := apiclient.DeleteCatalogCache(ctx, cacheHost, data.CatalogId.ValueString(), data.Version.ValueString()); evictDiag.HasError() {
+			resp.Diagnostics.Append(evictDiag...)
+			return
+		}
+	}
+}
+
+// ModifyPlan plans an in place update to warm again the orchestrator hosts that evicted the
+// image outside terraform, the hosts still caching it are kept
+func (r *CatalogCacheResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to warm if the resource is being created or destroyed
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	evictedHostIds, diags := getEvictedHostIds(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(evictedHostIds) == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "Catalog will be cached again in hosts "+strings.Join(evictedHostIds, ", "))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cached_host_ids"), types.ListUnknown(types.StringType))...)
+}
+
+// warmHost caches the catalog in the host and returns the cached item, the host id is added to
+// the warmed hosts as soon as it is cached so it is evicted on destroy even if the lookup fails
+func warmHost(ctx context.Context, cacheHost apiclient.HostConfig, cacheRequest apimodels.CacheCatalogRequest, warmedHostIds *[]string) (*apimodels.CatalogCacheItem, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	tflog.Info(ctx, "Caching catalog "+cacheRequest.CatalogId+" version "+cacheRequest.Version+" in host "+cacheHost.HostId)
+	if cacheDiag := apiclient.CacheCatalog(ctx, cacheHost, cacheRequest); cacheDiag.HasError() {
+		diagnostics.Append(cacheDiag...)
+		return nil, diagnostics
+	}
+	if cacheHost.HostId != "" {
+		*warmedHostIds = append(*warmedHostIds, cacheHost.HostId)
+	}
+
+	cache, cacheDiag := apiclient.GetCatalogCache(ctx, cacheHost)
+	if cacheDiag.HasError() {
+		diagnostics.Append(cacheDiag...)
+		return nil, diagnostics
+	}
+
+	item := findCatalogCacheItem(cache, cacheRequest.CatalogId, cacheRequest.Version, cacheRequest.Architecture)
+	if item == nil {
+		diagnostics.AddError("Catalog not cached", "The catalog "+cacheRequest.CatalogId+" version "+cacheRequest.Version+" was not found in the host cache after caching it")
+		return nil, diagnostics
+	}
+
+	return item, diagnostics
+}
+
+func getCachedHostIds(ctx context.Context, data *resource_models.CatalogCacheResourceModelV0) ([]string, diag.Diagnostics) {
+	hostIds := make([]string, 0)
+	if data.CachedHostIds.IsNull() || data.CachedHostIds.IsUnknown() {
+		return hostIds, nil
+	}
+
+	diags := data.CachedHostIds.ElementsAs(ctx, &hostIds, false)
+	return hostIds, diags
+}
+
+func setCachedHostIds(ctx context.Context, data *resource_models.CatalogCacheResourceModelV0, hostIds []string) diag.Diagnostics {
+	value, diags := types.ListValueFrom(ctx, types.StringType, hostIds)
+	data.CachedHostIds = value
+	return diags
+}
+
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getEvictedHostIds returns the orchestrator hosts that evicted the image outside terraform, they
+// are kept in the private state until they are warmed again
+func getEvictedHostIds(ctx context.Context, private privateStateReader) ([]string, diag.Diagnostics) {
+	hostIds := make([]string, 0)
+	value, diags := private.GetKey(ctx, evictedHostsPrivateStateKey)
+	if diags.HasError() || len(value) == 0 {
+		return hostIds, diags
+	}
+
+	if err := json.Unmarshal(value, &hostIds); err != nil {
+		diags.AddError("error reading the evicted hosts", err.Error())
+	}
+
+	return hostIds, diags
+}
+
+func setEvictedHostIds(ctx context.Context, private privateStateWriter, hostIds []string) diag.Diagnostics {
+	// an empty value removes the key from the private state
+	if len(hostIds) == 0 {
+		return private.SetKey(ctx, evictedHostsPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(hostIds)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("error saving the evicted hosts", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, evictedHostsPrivateStateKey, value)
+}
+
+// findCatalogCacheItem returns the cached item for the catalog version, an empty architecture
+// matches any cached architecture
+func findCatalogCacheItem(cache *apimodels.CatalogCacheResponse, catalogId string, version string, architecture string) *apimodels.CatalogCacheItem {
+	if cache == nil {
+		return nil
+	}
+
+	for i, item := range cache.Manifests {
+		if !strings.EqualFold(item.CatalogId, catalogId) || !strings.EqualFold(item.Version, version) {
+			continue
+		}
+		if architecture != "" && !strings.EqualFold(item.Architecture, architecture) {
+			continue
+		}
+
+		return &cache.Manifests[i]
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CatalogCacheEvictActionSchemaV0 = schema.Schema{
+	MarkdownDescription: "Catalog Cache Evict Action, evicts catalog images from the cache of a host or of the orchestrator hosts to free disk space",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.ActionSchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.ActionSchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"host_ids": schema.ListAttribute{
+			MarkdownDescription: "Only evict from these orchestrator hosts, if empty all the enabled orchestrator hosts are evicted",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("orchestrator")),
+			},
+		},
+		"catalog_id": schema.StringAttribute{
+			MarkdownDescription: "Catalog Id to evict, if empty the whole cache is cleared",
+			Optional:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Catalog version to evict, if empty all the cached versions of the catalog are evicted",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("catalog_id")),
+			},
+		},
+	},
+}
@@ -0,0 +1,103 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CatalogCacheDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Catalog Cache Data Source, lists the catalog images cached in a host or in the orchestrator hosts",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"host_ids": schema.ListAttribute{
+			MarkdownDescription: "Only return the cache of these orchestrator hosts, if empty all the enabled orchestrator hosts are listed",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("orchestrator")),
+			},
+		},
+		"catalog_id": schema.StringAttribute{
+			MarkdownDescription: "Only return the cached images of this catalog id",
+			Optional:            true,
+		},
+		"total_size": schema.Int64Attribute{
+			MarkdownDescription: "The size of all the listed cached images",
+			Computed:            true,
+		},
+		"items": schema.ListNestedAttribute{
+			MarkdownDescription: "The cached images",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the cached catalog manifest",
+						Computed:            true,
+					},
+					"host_id": schema.StringAttribute{
+						MarkdownDescription: "The orchestrator host the image is cached in, empty when not using an orchestrator",
+						Computed:            true,
+					},
+					"catalog_id": schema.StringAttribute{
+						MarkdownDescription: "The catalog id of the cached image",
+						Computed:            true,
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The version of the cached image",
+						Computed:            true,
+					},
+					"architecture": schema.StringAttribute{
+						MarkdownDescription: "The architecture of the cached image",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of cache, either a packed or an unpacked image",
+						Computed:            true,
+					},
+					"size": schema.Int64Attribute{
+						MarkdownDescription: "The size the image takes in the cache",
+						Computed:            true,
+					},
+					"cached_at": schema.StringAttribute{
+						MarkdownDescription: "When the image was cached",
+						Computed:            true,
+					},
+					"last_used_at": schema.StringAttribute{
+						MarkdownDescription: "When the cached image was last used to create a machine",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
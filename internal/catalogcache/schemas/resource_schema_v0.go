@@ -0,0 +1,132 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetCatalogCacheSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Catalog Cache Resource\n Use this to pre-warm a catalog image in the cache of a host or of the orchestrator hosts, so machines created from it do not need to download it.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+		},
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+			},
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Cached catalog manifest Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_connection": schema.StringAttribute{
+				MarkdownDescription: "Parallels DevOps Catalog Connection",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.CatalogConnection(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				MarkdownDescription: "Catalog Id to cache",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Catalog version to cache",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "Catalog architecture, if empty it will be the architecture of each host. When using an orchestrator only the hosts with this architecture will be warmed",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_ids": schema.ListAttribute{
+				MarkdownDescription: "Orchestrator host ids to warm, if empty all the enabled orchestrator hosts will be warmed",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("orchestrator")),
+				},
+			},
+			"cached_host_ids": schema.ListAttribute{
+				MarkdownDescription: "Orchestrator host ids the image is cached in, a host that evicted the image outside terraform is warmed again in place on the next apply",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size the image takes in the cache of each host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"evict_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "If true the image will be evicted from the hosts cache when the resource is destroyed, otherwise it is kept",
+				Optional:            true,
+			},
+		},
+	}
+}
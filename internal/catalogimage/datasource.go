@@ -0,0 +1,137 @@
+package catalogimage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	data_models "terraform-provider-parallels-desktop/internal/catalogimage/models"
+	"terraform-provider-parallels-desktop/internal/catalogimage/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSource              = &CatalogImagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &CatalogImagesDataSource{}
+)
+
+func NewCatalogImagesDataSource() datasource.DataSource {
+	return &CatalogImagesDataSource{}
+}
+
+type CatalogImagesDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *CatalogImagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *CatalogImagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_images"
+}
+
+func (d *CatalogImagesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.CatalogImagesDataSourceSchemaV0
+}
+
+func (d *CatalogImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.CatalogImagesDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog_connection"), "error parsing host connection string", err.Error())
+		return
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || d.provider.DisableTlsValidation.ValueBool()
+
+	catalogs := map[string][]*apimodels.CatalogManifest{}
+	if data.CatalogId.ValueString() != "" {
+		manifests, diag := apiclient.GetCatalogManifests(ctx, *catalogHostConfig, data.CatalogId.ValueString())
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		catalogs[data.CatalogId.ValueString()] = manifests
+	} else {
+		allCatalogs, diag := apiclient.GetCatalogs(ctx, *catalogHostConfig)
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		for catalogId, manifests := range allCatalogs {
+			catalogs[catalogId] = manifests
+		}
+	}
+
+	tags := common.GetStrings(data.Tags)
+
+	catalogIds := make([]string, 0, len(catalogs))
+	for catalogId := range catalogs {
+		catalogIds = append(catalogIds, catalogId)
+	}
+	sort.Strings(catalogIds)
+
+	data.Images = make([]data_models.CatalogImageModelV0, 0)
+	for _, catalogId := range catalogIds {
+		manifests := common.FilterCatalogManifests(catalogs[catalogId], data.Architecture.ValueString(), tags)
+		if len(manifests) == 0 {
+			continue
+		}
+
+		// latest only makes sense within the same catalog id
+		if data.Version.ValueString() != "" && common.IsLatestCatalogVersion(data.Version.ValueString()) {
+			manifest, err := common.SelectCatalogManifest(manifests, data.Version.ValueString(), "")
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("version"), "Error resolving catalog version", err.Error())
+				return
+			}
+			data.Images = append(data.Images, data_models.NewCatalogImageModelV0(manifest))
+			continue
+		}
+
+		sort.SliceStable(manifests, func(i, j int) bool {
+			return manifests[i].CreatedAt.Before(manifests[j].CreatedAt)
+		})
+		for _, manifest := range manifests {
+			matches, err := common.MatchesCatalogVersion(manifest, data.Version.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid catalog version", err.Error())
+				return
+			}
+			if matches {
+				data.Images = append(data.Images, data_models.NewCatalogImageModelV0(manifest))
+			}
+		}
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
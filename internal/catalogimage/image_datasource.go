@@ -0,0 +1,106 @@
+package catalogimage
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	data_models "terraform-provider-parallels-desktop/internal/catalogimage/models"
+	"terraform-provider-parallels-desktop/internal/catalogimage/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSource              = &CatalogImageDataSource{}
+	_ datasource.DataSourceWithConfigure = &CatalogImageDataSource{}
+)
+
+func NewCatalogImageDataSource() datasource.DataSource {
+	return &CatalogImageDataSource{}
+}
+
+type CatalogImageDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *CatalogImageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *CatalogImageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_image"
+}
+
+func (d *CatalogImageDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.CatalogImageDataSourceSchemaV0
+}
+
+func (d *CatalogImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.CatalogImageDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog_connection"), "error parsing host connection string", err.Error())
+		return
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || d.provider.DisableTlsValidation.ValueBool()
+
+	manifests, diag := apiclient.GetCatalogManifests(ctx, *catalogHostConfig, data.CatalogId.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	tags := common.GetStrings(data.Tags)
+
+	manifests = common.FilterCatalogManifests(manifests, data.Architecture.ValueString(), tags)
+	if len(manifests) == 0 {
+		resp.Diagnostics.AddError("Catalog image not found", "Did not find any image for "+data.CatalogId.ValueString()+" matching the architecture and tags")
+		return
+	}
+
+	manifest, err := common.SelectCatalogManifest(manifests, data.Version.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Catalog image not found", err.Error())
+		return
+	}
+
+	image := data_models.NewCatalogImageModelV0(manifest)
+	data.ID = image.ID
+	data.ResolvedVersion = image.Version
+	data.Description = image.Description
+	data.Type = image.Type
+	data.RequiredRoles = image.RequiredRoles
+	data.DownloadCount = image.DownloadCount
+	data.Size = image.Size
+	data.CreatedAt = image.CreatedAt
+	data.UpdatedAt = image.UpdatedAt
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogImagesDataSourceModelV0 represents the data source schema for the catalog_images data source.
+type CatalogImagesDataSourceModelV0 struct {
+	CatalogConnection types.String          `tfsdk:"catalog_connection"`
+	CatalogId         types.String          `tfsdk:"catalog_id"`
+	Version           types.String          `tfsdk:"version"`
+	Architecture      types.String          `tfsdk:"architecture"`
+	Tags              []types.String        `tfsdk:"tags"`
+	Images            []CatalogImageModelV0 `tfsdk:"images"`
+}
+
+// CatalogImageDataSourceModelV0 represents the data source schema for the catalog_image data source.
+type CatalogImageDataSourceModelV0 struct {
+	CatalogConnection types.String   `tfsdk:"catalog_connection"`
+	CatalogId         types.String   `tfsdk:"catalog_id"`
+	Version           types.String   `tfsdk:"version"`
+	Architecture      types.String   `tfsdk:"architecture"`
+	Tags              []types.String `tfsdk:"tags"`
+	ID                types.String   `tfsdk:"id"`
+	ResolvedVersion   types.String   `tfsdk:"resolved_version"`
+	Description       types.String   `tfsdk:"description"`
+	Type              types.String   `tfsdk:"type"`
+	RequiredRoles     []types.String `tfsdk:"required_roles"`
+	DownloadCount     types.Int64    `tfsdk:"download_count"`
+	Size              types.Int64    `tfsdk:"size"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+}
+
+// CatalogImageModelV0 represents a catalog manifest.
+type CatalogImageModelV0 struct {
+	ID            types.String   `tfsdk:"id"`             // The unique identifier of the catalog manifest.
+	CatalogId     types.String   `tfsdk:"catalog_id"`     // The catalog id the manifest belongs to.
+	Version       types.String   `tfsdk:"version"`        // The version of the catalog manifest.
+	Architecture  types.String   `tfsdk:"architecture"`   // The architecture of the catalog manifest.
+	Description   types.String   `tfsdk:"description"`    // The description of the catalog manifest.
+	Type          types.String   `tfsdk:"type"`           // The type of machine in the catalog manifest.
+	Tags          []types.String `tfsdk:"tags"`           // The tags of the catalog manifest.
+	RequiredRoles []types.String `tfsdk:"required_roles"` // The roles required to pull the catalog manifest.
+	DownloadCount types.Int64    `tfsdk:"download_count"` // The number of times the catalog manifest was pulled.
+	Size          types.Int64    `tfsdk:"size"`           // The size of the catalog manifest.
+	CreatedAt     types.String   `tfsdk:"created_at"`     // The date the catalog manifest was created.
+	UpdatedAt     types.String   `tfsdk:"updated_at"`     // The date the catalog manifest was last updated.
+}
+
+// NewCatalogImageModelV0 maps a catalog manifest into the data source model
+func NewCatalogImageModelV0(manifest *apimodels.CatalogManifest) CatalogImageModelV0 {
+	return CatalogImageModelV0{
+		ID:            types.StringValue(manifest.ID),
+		CatalogId:     types.StringValue(manifest.CatalogID),
+		Version:       types.StringValue(manifest.Version),
+		Architecture:  types.StringValue(manifest.Architecture),
+		Description:   types.StringValue(manifest.Description),
+		Type:          types.StringValue(manifest.Type),
+		Tags:          stringValues(manifest.Tags),
+		RequiredRoles: stringValues(manifest.RequiredRoles),
+		DownloadCount: types.Int64Value(manifest.DownloadCount),
+		Size:          types.Int64Value(manifest.Size),
+		CreatedAt:     types.StringValue(manifest.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:     types.StringValue(manifest.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
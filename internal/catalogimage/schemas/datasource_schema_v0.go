@@ -0,0 +1,166 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var CatalogImagesDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Catalog Images Data Source, lists the images published in a Parallels DevOps catalog",
+	Attributes: map[string]schema.Attribute{
+		"catalog_connection": schema.StringAttribute{
+			MarkdownDescription: "Parallels DevOps Catalog Connection",
+			Required:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				validators.CatalogConnection(),
+			},
+		},
+		"catalog_id": schema.StringAttribute{
+			MarkdownDescription: "Only return the images of this catalog id",
+			Optional:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Only return the images matching this version, it can be an exact version, `latest` or a version constraint like `~> 1.2`",
+			Optional:            true,
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "Only return the images built for this architecture",
+			Optional:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Only return the images containing all of these tags",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"images": schema.ListNestedAttribute{
+			MarkdownDescription: "The images matching the filters",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the catalog manifest",
+						Computed:            true,
+					},
+					"catalog_id": schema.StringAttribute{
+						MarkdownDescription: "The catalog id the image belongs to",
+						Computed:            true,
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The version of the image",
+						Computed:            true,
+					},
+					"architecture": schema.StringAttribute{
+						MarkdownDescription: "The architecture of the image",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the image",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of machine in the image",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "The tags of the image",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"required_roles": schema.ListAttribute{
+						MarkdownDescription: "The roles required to pull the image",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"download_count": schema.Int64Attribute{
+						MarkdownDescription: "The number of times the image was pulled",
+						Computed:            true,
+					},
+					"size": schema.Int64Attribute{
+						MarkdownDescription: "The size of the image",
+						Computed:            true,
+					},
+					"created_at": schema.StringAttribute{
+						MarkdownDescription: "The date the image was published",
+						Computed:            true,
+					},
+					"updated_at": schema.StringAttribute{
+						MarkdownDescription: "The date the image was last updated",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
+
+var CatalogImageDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Catalog Image Data Source, finds a single image published in a Parallels DevOps catalog",
+	Attributes: map[string]schema.Attribute{
+		"catalog_connection": schema.StringAttribute{
+			MarkdownDescription: "Parallels DevOps Catalog Connection",
+			Required:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				validators.CatalogConnection(),
+			},
+		},
+		"catalog_id": schema.StringAttribute{
+			MarkdownDescription: "The catalog id of the image",
+			Required:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The version of the image, it can be an exact version, `latest` or a version constraint like `~> 1.2`. If empty the latest version is used",
+			Optional:            true,
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "The architecture of the image",
+			Optional:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "The image needs to contain all of these tags",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the catalog manifest",
+			Computed:            true,
+		},
+		"resolved_version": schema.StringAttribute{
+			MarkdownDescription: "The version of the image that matched the version attribute",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the image",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of machine in the image",
+			Computed:            true,
+		},
+		"required_roles": schema.ListAttribute{
+			MarkdownDescription: "The roles required to pull the image",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"download_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of times the image was pulled",
+			Computed:            true,
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "The size of the image",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The date the image was published",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The date the image was last updated",
+			Computed:            true,
+		},
+	},
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogPushResourceModelV0 describes the resource data model.
+type CatalogPushResourceModelV0 struct {
+	Authenticator     *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel         *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host              types.String                  `tfsdk:"host"`
+	Orchestrator      types.String                  `tfsdk:"orchestrator"`
+	ID                types.String                  `tfsdk:"id"`
+	VmId              types.String                  `tfsdk:"vm_id"`
+	CatalogConnection types.String                  `tfsdk:"catalog_connection"`
+	CatalogId         types.String                  `tfsdk:"catalog_id"`
+	Version           types.String                  `tfsdk:"version"`
+	Architecture      types.String                  `tfsdk:"architecture"`
+	Description       types.String                  `tfsdk:"description"`
+	Tags              []types.String                `tfsdk:"tags"`
+	RequiredRoles     []types.String                `tfsdk:"required_roles"`
+	RequiredClaims    []types.String                `tfsdk:"required_claims"`
+	Size              types.Int64                   `tfsdk:"size"`
+	DeleteOnDestroy   types.Bool                    `tfsdk:"delete_on_destroy"`
+	Timeouts          timeouts.Value                `tfsdk:"timeouts"`
+}
@@ -0,0 +1,299 @@
+package catalogpush
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	resource_models "terraform-provider-parallels-desktop/internal/catalogpush/models"
+	"terraform-provider-parallels-desktop/internal/catalogpush/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &CatalogPushResource{}
+)
+
+func NewCatalogPushResource() resource.Resource {
+	return &CatalogPushResource{}
+}
+
+// CatalogPushResource defines the resource implementation.
+type CatalogPushResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *CatalogPushResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_push"
+}
+
+func (r *CatalogPushResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetCatalogPushSchemaV0(ctx)
+}
+
+func (r *CatalogPushResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *CatalogPushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.CatalogPushResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogPush, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout, pushing big machines can take a while
+	createTimeout, diags := data.Timeouts.Create(ctx, 120*time.Minute)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(ctx, hostConfig, data.VmId.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.Diagnostics.AddError("VM not found", "Could not find a VM with ID "+data.VmId.ValueString()+" in the host")
+		return
+	}
+
+	// the orchestrator needs to know which host the machine lives on to push it from there
+	hostConfig.HostId = vm.HostId
+
+	// the machine runs on the host architecture, we use it when it is not set
+	if data.Architecture.IsUnknown() || data.Architecture.IsNull() {
+		architecture, architectureDiag := common.GetHostArchitecture(ctx, hostConfig)
+		if architectureDiag.HasError() {
+			resp.Diagnostics.Append(architectureDiag...)
+			return
+		}
+		if architecture != "" {
+			data.Architecture = types.StringValue(architecture)
+		}
+	}
+
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
+		return
+	}
+
+	// the machine needs to be stopped to be packed, we will start it again after pushing it
+	wasRunning := vm.State != "stopped"
+	stoppedVm, stopDiag := common.EnsureMachineStopped(ctx, hostConfig, vm)
+	if stopDiag.HasError() {
+		resp.Diagnostics.Append(stopDiag...)
+		return
+	}
+
+	pushRequest := apimodels.PushCatalogRequest{
+		CatalogId:      data.CatalogId.ValueString(),
+		Version:        data.Version.ValueString(),
+		Architecture:   common.GetString(data.Architecture),
+		Connection:     catalogConnection,
+		LocalPath:      stoppedVm.Home,
+		Description:    data.Description.ValueString(),
+		Tags:           common.GetStrings(data.Tags),
+		RequiredRoles:  common.GetStrings(data.RequiredRoles),
+		RequiredClaims: common.GetStrings(data.RequiredClaims),
+	}
+
+	tflog.Info(ctx, "Pushing vm "+stoppedVm.Name+" to catalog "+pushRequest.CatalogId+" version "+pushRequest.Version)
+	manifest, pushDiag := apiclient.PushCatalog(ctx, hostConfig, pushRequest)
+
+	if wasRunning {
+		if _, startDiag := common.EnsureMachineRunning(ctx, hostConfig, stoppedVm); startDiag.HasError() {
+			resp.Diagnostics.Append(startDiag...)
+		}
+	}
+
+	if pushDiag.HasError() {
+		resp.Diagnostics.Append(pushDiag...)
+		return
+	}
+
+	data.ID = types.StringValue(manifest.ID)
+	data.Size = types.Int64Value(manifest.Size)
+	if manifest.Architecture != "" && (data.Architecture.IsUnknown() || data.Architecture.IsNull()) {
+		data.Architecture = types.StringValue(manifest.Architecture)
+	}
+	if data.Architecture.IsUnknown() {
+		data.Architecture = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogPushResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.CatalogPushResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogPush, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
+		return
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || r.provider.DisableTlsValidation.ValueBool()
+
+	manifest, manifestDiag := apiclient.GetCatalogManifest(ctx, *catalogHostConfig, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString())
+	if manifestDiag.HasError() {
+		resp.Diagnostics.Append(manifestDiag...)
+		return
+	}
+
+	// the catalog version was removed outside terraform, it needs to be pushed again
+	if manifest == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(manifest.ID)
+	data.Size = types.Int64Value(manifest.Size)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogPushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.CatalogPushResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogPush, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	// every pushed value requires a new push, only the destroy behavior can change in place
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CatalogPushResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.CatalogPushResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventCatalogPush, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeleteOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Keeping catalog "+data.CatalogId.ValueString()+" version "+data.Version.ValueString()+" in the catalog")
+		return
+	}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
+		return
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || r.provider.DisableTlsValidation.ValueBool()
+
+	if deleteDiag := apiclient.DeleteCatalogManifest(ctx, *catalogHostConfig, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString()); deleteDiag.HasError() {
+		resp.Diagnostics.Append(deleteDiag...)
+		return
+	}
+}
@@ -0,0 +1,151 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetCatalogPushSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Catalog Push Resource\n Use this to publish a virtual machine to a Parallels DevOps catalog.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+		},
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+			},
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Catalog manifest Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_id": schema.StringAttribute{
+				MarkdownDescription: "Virtual Machine Id to push to the catalog, the machine will be stopped while it is pushed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_connection": schema.StringAttribute{
+				MarkdownDescription: "Parallels DevOps Catalog Connection",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.CatalogConnection(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				MarkdownDescription: "Catalog Id to push the machine to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Catalog version to publish",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "Catalog architecture, if empty it will be the architecture of the host",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Catalog description",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Catalog tags",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"required_roles": schema.ListAttribute{
+				MarkdownDescription: "Roles a user needs to have to pull this catalog",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"required_claims": schema.ListAttribute{
+				MarkdownDescription: "Claims a user needs to have to pull this catalog",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the pushed catalog",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "If true the catalog version will be deleted from the catalog when the resource is destroyed, otherwise it is kept",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package claim
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	data_models "terraform-provider-parallels-desktop/internal/claim/models"
+	"terraform-provider-parallels-desktop/internal/claim/schemas"
+	"terraform-provider-parallels-desktop/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ClaimDataSource{}
+	_ datasource.DataSourceWithConfigure = &ClaimDataSource{}
+)
+
+func NewClaimDataSource() datasource.DataSource {
+	return &ClaimDataSource{}
+}
+
+type ClaimDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *ClaimDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *ClaimDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_claim"
+}
+
+func (d *ClaimDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.ClaimDataSourceSchemaV0
+}
+
+func (d *ClaimDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.ClaimDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	lookup := data.ID.ValueString()
+	if lookup == "" {
+		lookup = data.Name.ValueString()
+	}
+
+	claim, diag := apiclient.GetClaim(ctx, hostConfig, lookup)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if claim == nil {
+		resp.Diagnostics.AddError("Claim not found", "Could not find the claim "+lookup+" in the host")
+		return
+	}
+
+	data.ID = types.StringValue(claim.ID)
+	data.Name = types.StringValue(claim.Name)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClaimDataSourceModelV0 represents the data source schema for the claim data source.
+type ClaimDataSourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Name          types.String                  `tfsdk:"name"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClaimResourceModelV0 represents the resource schema for the claim resource.
+type ClaimResourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Name          types.String                  `tfsdk:"name"`
+}
+
+// FromApiModel sets the id and name from the host, the configured name is kept when it only
+// differs in case as the host normalizes claim names
+func (m *ClaimResourceModelV0) FromApiModel(claim *apimodels.ClaimRoleResponse) {
+	m.ID = types.StringValue(claim.ID)
+	if !strings.EqualFold(m.Name.ValueString(), claim.Name) {
+		m.Name = types.StringValue(claim.Name)
+	}
+}
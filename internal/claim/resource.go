@@ -0,0 +1,220 @@
+package claim
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	resource_models "terraform-provider-parallels-desktop/internal/claim/models"
+	"terraform-provider-parallels-desktop/internal/claim/schemas"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ClaimResource{}
+	_ resource.ResourceWithImportState = &ClaimResource{}
+)
+
+func NewClaimResource() resource.Resource {
+	return &ClaimResource{}
+}
+
+// ClaimResource defines the resource implementation.
+type ClaimResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *ClaimResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_claim"
+}
+
+func (r *ClaimResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetClaimSchemaV0(ctx)
+}
+
+func (r *ClaimResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *ClaimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.ClaimResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventClaim, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+
+	existingClaim, diag := apiclient.GetClaim(ctx, hostConfig, data.Name.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if existingClaim != nil {
+		resp.Diagnostics.AddError("claim already exists", fmt.Sprintf("claim %s already exists, import it to manage it with terraform", data.Name.ValueString()))
+		return
+	}
+
+	tflog.Info(ctx, "Creating claim "+data.Name.ValueString())
+	claim, diag := apiclient.CreateClaim(ctx, hostConfig, data.Name.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	data.FromApiModel(claim)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.ClaimResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventClaim, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claim, diag := apiclient.GetClaim(ctx, r.getHostConfig(data), data.ID.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	// the claim was deleted outside terraform, it needs to be created again
+	if claim == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.FromApiModel(claim)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.ClaimResourceModelV0
+	var currentData resource_models.ClaimResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventClaim, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every claim attribute requires a replacement, only the connection details can change here
+	data.ID = currentData.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ClaimResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.ClaimResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventClaim, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diag := apiclient.DeleteClaim(ctx, r.getHostConfig(data), data.ID.ValueString()); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+}
+
+// ImportState sets the host, credentials and claim id from the import id in the format
+// [username:password@]host@claim_id_or_name, the Read then rebuilds the rest of the state
+func (r *ClaimResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, auth, claimId, err := common.ParseImportId(req.ID, "claim_id_or_name", "claim id or name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), claimId)...)
+	if auth != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), auth)...)
+	}
+}
+
+func (r *ClaimResource) getHostConfig(data resource_models.ClaimResourceModelV0) apiclient.HostConfig {
+	return apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+}
@@ -0,0 +1,41 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ClaimDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Claim Data Source, looks up a claim in a Parallels Desktop DevOps host by its id or name",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Required:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Claim id",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRoot("id"),
+					path.MatchRoot("name"),
+				}...),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Claim name",
+			Optional:            true,
+			Computed:            true,
+		},
+	},
+}
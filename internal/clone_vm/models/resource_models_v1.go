@@ -6,6 +6,7 @@ import (
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 
@@ -16,11 +17,17 @@ import (
 // CloneVmResourceModelV0 describes the resource data model.
 type CloneVmResourceModelV1 struct {
 	Authenticator        *authenticator.Authentication              `tfsdk:"authenticator"`
+	SshTunnel            *sshtunnel.SshTunnel                       `tfsdk:"ssh_tunnel"`
 	Host                 types.String                               `tfsdk:"host"`
 	Orchestrator         types.String                               `tfsdk:"orchestrator"`
 	ID                   types.String                               `tfsdk:"id"`
 	OsType               types.String                               `tfsdk:"os_type"`
 	BaseVmId             types.String                               `tfsdk:"base_vm_id"`
+	BaseTemplateId       types.String                               `tfsdk:"base_template_id"`
+	Linked               types.Bool                                 `tfsdk:"linked"`
+	BaseSnapshot         types.String                               `tfsdk:"base_snapshot"`
+	BaseSnapshotId       types.String                               `tfsdk:"base_snapshot_id"`
+	Template             types.Bool                                 `tfsdk:"template"`
 	ExternalIp           types.String                               `tfsdk:"external_ip"`
 	InternalIp           types.String                               `tfsdk:"internal_ip"`
 	Name                 types.String                               `tfsdk:"name"`
@@ -11,13 +11,17 @@ import (
 	"terraform-provider-parallels-desktop/internal/clone_vm/schemas"
 	"terraform-provider-parallels-desktop/internal/common"
 	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 	"terraform-provider-parallels-desktop/internal/telemetry"
+	"terraform-provider-parallels-desktop/internal/vmplan"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,6 +31,7 @@ import (
 var (
 	_ resource.Resource                = &CloneVmResource{}
 	_ resource.ResourceWithImportState = &CloneVmResource{}
+	_ resource.ResourceWithModifyPlan  = &CloneVmResource{}
 )
 
 func NewCloneVmResource() resource.Resource {
@@ -113,12 +118,30 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// templates cannot be started, so anything that requires a running vm is not supported
+	if data.Template.ValueBool() {
+		if data.KeepRunning.ValueBool() {
+			resp.Diagnostics.AddError("template cannot be started", "A template cannot be kept running, please set keep_running to false")
+			return
+		}
+		if data.RunAfterCreate.ValueBool() {
+			resp.Diagnostics.AddError("template cannot be started", "A template cannot be started after it is created, please set run_after_create to false")
+			return
+		}
+		if len(data.PostProcessorScripts) > 0 || len(data.ReverseProxyHosts) > 0 {
+			resp.Diagnostics.AddError("template cannot be started", "Post processor scripts and reverse proxy hosts are not supported when creating a template")
+			return
+		}
+	}
+
 	hostConfig := apiclient.HostConfig{
 		Host:                 host,
 		IsOrchestrator:       isOrchestrator,
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	if !isOrchestrator {
@@ -145,23 +168,50 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// the clone can either be based on a regular vm or on a template
+	baseId := data.BaseVmId.ValueString()
+	if data.BaseTemplateId.ValueString() != "" {
+		baseId = data.BaseTemplateId.ValueString()
+	}
+
 	// Checking if we can find the base vm to clone
-	vm, getVmDiag := apiclient.GetVm(ctx, hostConfig, data.BaseVmId.ValueString())
+	vm, getVmDiag := apiclient.GetVm(ctx, hostConfig, baseId)
 	if getVmDiag.HasError() {
 		resp.Diagnostics.Append(getVmDiag...)
 		return
 	}
 
 	if vm == nil {
-		resp.Diagnostics.AddError("Base VM does not exist", "Could not find a base VM with ID "+data.BaseVmId.ValueString()+" in the host")
+		resp.Diagnostics.AddError("Base VM does not exist", "Could not find a base VM with ID "+baseId+" in the host")
 		return
 	}
 
-	if vm.State != "stopped" {
+	if data.BaseTemplateId.ValueString() != "" && !vm.IsTemplate() {
+		resp.Diagnostics.AddError("Base VM is not a template", "The base VM "+vm.Name+" is not a template, use base_vm_id to clone a regular VM")
+		return
+	}
+
+	// linked clones are created from a snapshot so the base vm does not need to be stopped
+	if vm.State != "stopped" && !data.Linked.ValueBool() {
 		resp.Diagnostics.AddError("Base VM must be stopped", "The base VM "+vm.Name+" must be stopped before cloning, currently "+vm.State)
 		return
 	}
 
+	if data.BaseSnapshot.ValueString() != "" && !data.Linked.ValueBool() {
+		resp.Diagnostics.AddError("Linked clone required", "Cloning from the base VM snapshot "+data.BaseSnapshot.ValueString()+" requires linked to be set to true")
+		return
+	}
+
+	baseSnapshotId := ""
+	if data.BaseSnapshot.ValueString() != "" {
+		snapshot, snapshotDiag := getBaseSnapshot(ctx, hostConfig, vm, data.BaseSnapshot.ValueString())
+		if snapshotDiag.HasError() {
+			resp.Diagnostics.Append(snapshotDiag...)
+			return
+		}
+		baseSnapshotId = snapshot.ID
+	}
+
 	cloneRequest := apimodels.NewVmConfigRequest(vm.User)
 	op := apimodels.NewVmConfigRequestOperation(cloneRequest)
 	op.WithGroup("machine")
@@ -172,7 +222,20 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 		op.WithOption("dst", data.Path.ValueString())
 	}
 
-	op.WithFlag("regenerate-src-uuid")
+	if data.Linked.ValueBool() {
+		// linked clones keep a reference to the base vm, so we cannot regenerate its uuid
+		op.WithFlag("linked")
+		if baseSnapshotId != "" {
+			op.WithOption("id", baseSnapshotId)
+		}
+	} else {
+		op.WithFlag("regenerate-src-uuid")
+	}
+
+	if data.Template.ValueBool() {
+		op.WithFlag("template")
+	}
+
 	op.Append()
 
 	_, createdVmDiag := apiclient.ConfigureMachine(ctx, hostConfig, vm.ID, cloneRequest)
@@ -197,13 +260,14 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	if len(createdVms) != 1 {
-		resp.Diagnostics.AddError("Cloned Machine not Found", "Could not find the created clone machine of "+data.BaseVmId.ValueString()+" in the host")
+		resp.Diagnostics.AddError("Cloned Machine not Found", "Could not find the created clone machine of "+baseId+" in the host")
 		return
 	}
 	clonedVm := createdVms[0]
 
 	data.ID = types.StringValue(clonedVm.ID)
-	tflog.Info(ctx, "Cloned base vm "+data.BaseVmId.ValueString()+" with new id "+data.ID.ValueString())
+	data.BaseSnapshotId = types.StringValue(baseSnapshotId)
+	tflog.Info(ctx, "Cloned base vm "+baseId+" with new id "+data.ID.ValueString())
 
 	// stopping the machine as it might need some operations where the machine needs to be stopped
 	// add anything here in sequence that needs to be done before the machine is started
@@ -318,7 +382,7 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Starting the vm by default, otherwise we will stop the VM from being created
-	if data.RunAfterCreate.ValueBool() || data.KeepRunning.ValueBool() || (data.RunAfterCreate.IsUnknown() && data.KeepRunning.IsUnknown()) {
+	if !data.Template.ValueBool() && (data.RunAfterCreate.ValueBool() || data.KeepRunning.ValueBool() || (data.RunAfterCreate.IsUnknown() && data.KeepRunning.IsUnknown())) {
 		if _, diag := common.EnsureMachineRunning(ctx, hostConfig, stoppedVm); diag.HasError() {
 			resp.Diagnostics.Append(diag...)
 			if data.ID.ValueString() != "" {
@@ -391,6 +455,9 @@ func (r *CloneVmResource) Create(ctx context.Context, req resource.CreateRequest
 	data.ExternalIp = types.StringValue(externalIp)
 	data.InternalIp = types.StringValue(internalIp)
 	data.OsType = types.StringValue(clonedVm.OS)
+	if data.Owner.IsUnknown() {
+		data.Owner = types.StringValue(clonedVm.User)
+	}
 	if data.OnDestroyScript != nil {
 		for _, script := range data.OnDestroyScript {
 			elements := make([]attr.Value, 0)
@@ -487,6 +554,8 @@ func (r *CloneVmResource) Read(ctx context.Context, req resource.ReadRequest, re
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -499,9 +568,30 @@ func (r *CloneVmResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// the resource was imported, rebuilding the state from the machine
+	if data.Name.IsNull() {
+		data.ID = types.StringValue(vm.ID)
+		data.Owner = types.StringValue(vm.User)
+		data.OsType = types.StringValue(vm.OS)
+		data.ExternalIp = types.StringValue(vm.HostExternalIpAddress)
+		data.InternalIp = types.StringValue(vm.InternalIpAddress)
+		data.Specs = vmspecs.NewFromVirtualMachine(vm)
+		data.Config = vmconfig.NewFromVirtualMachine(vm)
+		data.SharedFolder = sharedfolder.NewFromVirtualMachine(vm)
+	} else {
+		// picking up any change done to the machine outside terraform
+		if data.Specs != nil {
+			data.Specs.RefreshFromVirtualMachine(vm)
+		}
+		if data.Config != nil {
+			data.Config.RefreshFromVirtualMachine(vm)
+		}
+		data.SharedFolder = sharedfolder.RefreshFromVirtualMachine(vm, data.SharedFolder)
+	}
+
 	data.Name = types.StringValue(vm.Name)
 
-	resp.Diagnostics.Append(req.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -560,6 +650,8 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, getVmDiag := apiclient.GetVm(ctx, hostConfig, currentData.ID.ValueString())
@@ -590,8 +682,9 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 	configChanges := common.VmConfigBlockHasChanges(ctx, hostConfig, vm, data.Config, currentData.Config)
 	specsChanges := common.SpecsBlockHasChanges(ctx, hostConfig, vm, data.Specs, currentData.Specs)
 	prlctlChanges := common.PrlCtlBlockHasChanges(ctx, hostConfig, vm, data.PrlCtl, currentData.PrlCtl)
+	sharedFoldersChanges := common.SharedFoldersBlockHasChanges(ctx, data.SharedFolder, currentData.SharedFolder)
 	postProcessorScriptChanges := common.PostProcessorHasChanges(ctx, data.PostProcessorScripts, currentData.PostProcessorScripts)
-	if specsChanges || configChanges || prlctlChanges || nameChanges.HasChanges() {
+	if specsChanges || configChanges || prlctlChanges || sharedFoldersChanges || nameChanges.HasChanges() {
 		requireShutdown = true
 	}
 
@@ -635,6 +728,14 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// Processing shared folders
+	if sharedFoldersChanges {
+		if diag := common.SharedFoldersBlockOnUpdate(ctx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
 	// Restarting the machine if needed
 	if needsRestart || (vm.State == "stopped" && currentState == "running") {
 		if newVm, startDiags := common.EnsureMachineRunning(ctx, hostConfig, vm); startDiags.HasError() {
@@ -645,12 +746,6 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	// Processing shared folders
-	if diag := common.SharedFoldersBlockOnUpdate(ctx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
-		resp.Diagnostics.Append(diag...)
-		return
-	}
-
 	// Running the post processor scripts
 	if postProcessorScriptChanges {
 		if diag := common.RunPostProcessorScript(ctx, hostConfig, vm, data.PostProcessorScripts); diag.HasError() {
@@ -683,7 +778,7 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Starting the vm by default, otherwise we will stop the VM from being created
-	if data.RunAfterCreate.ValueBool() || data.KeepRunning.ValueBool() || (data.RunAfterCreate.IsUnknown() && data.KeepRunning.IsUnknown()) {
+	if !data.Template.ValueBool() && (data.RunAfterCreate.ValueBool() || data.KeepRunning.ValueBool() || (data.RunAfterCreate.IsUnknown() && data.KeepRunning.IsUnknown())) {
 		if _, diag := common.EnsureMachineRunning(ctx, hostConfig, vm); diag.HasError() {
 			resp.Diagnostics.Append(diag...)
 			if data.ID.ValueString() != "" {
@@ -821,12 +916,19 @@ func (r *CloneVmResource) Update(ctx context.Context, req resource.UpdateRequest
 	// 	data.KeepRunning = types.BoolValue(false)
 	// }
 
+	// the first update after an import has set all the values we could not read from the machine
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, nil)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+func (r *CloneVmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	vmplan.ModifyPlan(ctx, r.provider, req, resp, vmplan.Options{})
+}
+
 func (r *CloneVmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data resource_models.CloneVmResourceModelV1
 	// Read Terraform prior state data into the model
@@ -879,6 +981,8 @@ func (r *CloneVmResource) Delete(ctx context.Context, req resource.DeleteRequest
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -918,7 +1022,7 @@ func (r *CloneVmResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *CloneVmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	common.ImportVmState(ctx, r.provider, req, resp)
 }
 
 func (r *CloneVmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -1011,11 +1115,18 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.Cl
 			host.TcpRoute.TargetPort = data.ReverseProxyHosts[i].TcpRoute.TargetPort
 			host.TcpRoute.TargetHost = types.StringValue(internalIp)
 			host.TcpRoute.TargetVmId = types.StringValue(emptyString)
+			host.TcpRoute.HealthCheck = data.ReverseProxyHosts[i].TcpRoute.HealthCheck
+			// a route with targets balances between them instead of routing to this machine
+			if len(data.ReverseProxyHosts[i].TcpRoute.Targets) > 0 {
+				host.TcpRoute.TargetHost = types.StringValue(emptyString)
+				host.TcpRoute.Targets = data.ReverseProxyHosts[i].TcpRoute.Targets
+				host.TcpRoute.LoadBalancing = data.ReverseProxyHosts[i].TcpRoute.LoadBalancing
+			}
 		}
 
 		if len(data.ReverseProxyHosts[i].HttpRoute) > 0 {
 			host.HttpRoute = make([]*reverseproxy.ReverseProxyHttpRoute, len(data.ReverseProxyHosts[i].HttpRoute))
-			for j := range modifiedHosts[i].HttpRoute {
+			for j := range data.ReverseProxyHosts[i].HttpRoute {
 				httpRoute := reverseproxy.ReverseProxyHttpRoute{}
 				httpRoute.Path = data.ReverseProxyHosts[i].HttpRoute[j].Path
 				httpRoute.TargetHost = types.StringValue(internalIp)
@@ -1025,6 +1136,12 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.Cl
 				httpRoute.Schema = data.ReverseProxyHosts[i].HttpRoute[j].Schema
 				httpRoute.RequestHeaders = data.ReverseProxyHosts[i].HttpRoute[j].RequestHeaders
 				httpRoute.ResponseHeaders = data.ReverseProxyHosts[i].HttpRoute[j].ResponseHeaders
+				httpRoute.HealthCheck = data.ReverseProxyHosts[i].HttpRoute[j].HealthCheck
+				if len(data.ReverseProxyHosts[i].HttpRoute[j].Targets) > 0 {
+					httpRoute.TargetHost = types.StringValue(emptyString)
+					httpRoute.Targets = data.ReverseProxyHosts[i].HttpRoute[j].Targets
+					httpRoute.LoadBalancing = data.ReverseProxyHosts[i].HttpRoute[j].LoadBalancing
+				}
 				host.HttpRoute[j] = &httpRoute
 			}
 		}
@@ -1034,3 +1151,21 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.Cl
 
 	return modifiedHosts, resultDiagnostic
 }
+
+func getBaseSnapshot(ctx context.Context, hostConfig apiclient.HostConfig, baseVm *apimodels.VirtualMachine, snapshotNameOrId string) (*apimodels.VmSnapshot, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	snapshots, snapshotsDiag := apiclient.GetVmSnapshots(ctx, hostConfig, baseVm.ID)
+	if snapshotsDiag.HasError() {
+		diagnostics.Append(snapshotsDiag...)
+		return nil, diagnostics
+	}
+
+	if snapshots != nil {
+		if snapshot := snapshots.GetByIdOrName(snapshotNameOrId); snapshot != nil {
+			return snapshot, diagnostics
+		}
+	}
+
+	diagnostics.AddError("Base VM snapshot does not exist", "Could not find a snapshot "+snapshotNameOrId+" in the base VM "+baseVm.Name)
+	return nil, diagnostics
+}
@@ -3,11 +3,13 @@ package schemas
 import (
 	"context"
 
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 
@@ -26,6 +28,7 @@ func GetCloneVmSchemaV1(ctx context.Context) schema.Schema {
 		MarkdownDescription: "Parallels Desktop Clone VM resource",
 		Blocks: map[string]schema.Block{
 			authenticator.SchemaName:       authenticator.SchemaBlock,
+			sshtunnel.SchemaName:           sshtunnel.SchemaBlock,
 			vmspecs.SchemaName:             vmspecs.SchemaBlock,
 			postprocessorscript.SchemaName: postprocessorscript.SchemaBlock,
 			"on_destroy_script":            postprocessorscript.SchemaBlock,
@@ -78,9 +81,56 @@ func GetCloneVmSchemaV1(ctx context.Context) schema.Schema {
 			},
 			"base_vm_id": schema.StringAttribute{
 				MarkdownDescription: "Base Virtual Machine Id to clone",
-				Required:            true,
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("base_vm_id"),
+						path.MatchRoot("base_template_id"),
+					}...),
+				},
+			},
+			"base_template_id": schema.StringAttribute{
+				MarkdownDescription: "Template Id to clone, usually the id of a `parallels-desktop_vm_template` resource so replacing the template also replaces its clones",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("base_vm_id"),
+						path.MatchRoot("base_template_id"),
+					}...),
+				},
+			},
+			"linked": schema.BoolAttribute{
+				MarkdownDescription: "Create a linked clone, linked clones share the base VM disk and are created almost instantly using minimal disk space",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					planmodifiers.BoolRequiresReplaceUnlessImported(),
+				},
+			},
+			"base_snapshot": schema.StringAttribute{
+				MarkdownDescription: "Name or Id of the base VM snapshot to clone from, this requires a linked clone",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+			},
+			"base_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the base VM snapshot the clone was created from",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template": schema.BoolAttribute{
+				MarkdownDescription: "Create the clone as a template, templates cannot be started and are used as a base for other clones",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					planmodifiers.BoolRequiresReplaceUnlessImported(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -90,7 +140,9 @@ func GetCloneVmSchemaV1(ctx context.Context) schema.Schema {
 			"owner": schema.StringAttribute{
 				MarkdownDescription: "Virtual Machine owner",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -98,7 +150,7 @@ func GetCloneVmSchemaV1(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Path",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"run_after_create": schema.BoolAttribute{
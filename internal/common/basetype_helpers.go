@@ -27,6 +27,17 @@ func GetString(c types.String) string {
 	return c.ValueString()
 }
 
+func GetStrings(c []types.String) []string {
+	result := make([]string, 0, len(c))
+	for _, value := range c {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
 func CopyPointer[T any](src *T) *T {
 	if src == nil {
 		return nil
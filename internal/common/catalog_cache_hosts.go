@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// GetCatalogCacheHosts returns the host configurations the catalog cache operations should run against.
+// For a standalone host this is the host itself, for an orchestrator it is every enabled host, or only the
+// hosts in hostIds if any are set, optionally limited to the hosts of the given architecture
+func GetCatalogCacheHosts(ctx context.Context, hostConfig apiclient.HostConfig, hostIds []string, architecture string) ([]apiclient.HostConfig, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	if !hostConfig.IsOrchestrator {
+		return []apiclient.HostConfig{hostConfig}, diagnostics
+	}
+
+	orchestratorHosts, hostsDiag := apiclient.GetOrchestratorHosts(ctx, hostConfig)
+	if hostsDiag.HasError() {
+		diagnostics.Append(hostsDiag...)
+		return nil, diagnostics
+	}
+
+	result := make([]apiclient.HostConfig, 0)
+	for _, hostId := range hostIds {
+		found := false
+		for _, orchestratorHost := range orchestratorHosts {
+			if strings.EqualFold(orchestratorHost.ID, hostId) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diagnostics.AddError("Orchestrator host not found", "Could not find the host "+hostId+" in the orchestrator")
+			return nil, diagnostics
+		}
+	}
+
+	for _, orchestratorHost := range orchestratorHosts {
+		if len(hostIds) > 0 {
+			selected := false
+			for _, hostId := range hostIds {
+				if strings.EqualFold(orchestratorHost.ID, hostId) {
+					selected = true
+					break
+				}
+			}
+			if !selected {
+				continue
+			}
+		} else {
+			if !orchestratorHost.Enabled {
+				continue
+			}
+			if architecture != "" && normalizeArchitecture(orchestratorHost.Architecture) != normalizeArchitecture(architecture) {
+				continue
+			}
+		}
+
+		config := hostConfig
+		config.HostId = orchestratorHost.ID
+		result = append(result, config)
+	}
+
+	return result, diagnostics
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// CheckHostArchitecture checks if the host, or at least one of the orchestrator hosts, is able
+// to run a machine with the given architecture
+func CheckHostArchitecture(ctx context.Context, hostConfig apiclient.HostConfig, architecture string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if architecture == "" {
+		return diagnostics
+	}
+
+	if hostConfig.IsOrchestrator {
+		orchestratorResources, orchestratorDiag := apiclient.GetOrchestratorResources(ctx, hostConfig)
+		if orchestratorDiag.HasError() {
+			diagnostics.Append(orchestratorDiag...)
+			return diagnostics
+		}
+
+		for _, orchestratorResource := range orchestratorResources {
+			if normalizeArchitecture(orchestratorResource.CpuType) == normalizeArchitecture(architecture) {
+				return diagnostics
+			}
+		}
+
+		diagnostics.AddError("Architecture not supported", fmt.Sprintf("Did not find any hosts for %s architecture in the orchestrator %s", architecture, hostConfig.Host))
+		return diagnostics
+	}
+
+	hardwareInfo, hardwareDiag := apiclient.GetSystemUsage(ctx, hostConfig)
+	if hardwareDiag.HasError() {
+		diagnostics.Append(hardwareDiag...)
+		return diagnostics
+	}
+
+	// older hosts do not report the cpu type, we cannot check it
+	if hardwareInfo == nil || hardwareInfo.CpuType == "" {
+		return diagnostics
+	}
+
+	if normalizeArchitecture(hardwareInfo.CpuType) != normalizeArchitecture(architecture) {
+		diagnostics.AddError("Architecture not supported", fmt.Sprintf("The host %s is %s and cannot run a %s machine", hostConfig.Host, hardwareInfo.CpuType, architecture))
+	}
+
+	return diagnostics
+}
+
+// GetHostArchitecture returns the architecture of the host, when using an orchestrator the
+// HostId needs to be set to know which host to check. It is empty if the host does not report it
+func GetHostArchitecture(ctx context.Context, hostConfig apiclient.HostConfig) (string, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	if hostConfig.IsOrchestrator {
+		if hostConfig.HostId == "" {
+			return "", diagnostics
+		}
+
+		orchestratorHost, orchestratorDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, hostConfig.HostId)
+		if orchestratorDiag.HasError() {
+			diagnostics.Append(orchestratorDiag...)
+			return "", diagnostics
+		}
+		if orchestratorHost == nil {
+			return "", diagnostics
+		}
+
+		return normalizeArchitecture(orchestratorHost.Architecture), diagnostics
+	}
+
+	hardwareInfo, hardwareDiag := apiclient.GetSystemUsage(ctx, hostConfig)
+	if hardwareDiag.HasError() {
+		diagnostics.Append(hardwareDiag...)
+		return "", diagnostics
+	}
+	if hardwareInfo == nil {
+		return "", diagnostics
+	}
+
+	return normalizeArchitecture(hardwareInfo.CpuType), diagnostics
+}
+
+// IsSameArchitecture returns true if both architectures are the same, ignoring the different
+// names used for them like x86_64 and amd64
+func IsSameArchitecture(a string, b string) bool {
+	return normalizeArchitecture(a) == normalizeArchitecture(b)
+}
+
+func normalizeArchitecture(architecture string) string {
+	switch strings.ToLower(architecture) {
+	case "x86_64", "amd64", "x64":
+		return "amd64"
+	case "aarch64", "arm64", "arm":
+		return "arm64"
+	default:
+		return strings.ToLower(architecture)
+	}
+}
@@ -17,6 +17,21 @@ func CheckIfEnoughSpecs(ctx context.Context, hostConfig apiclient.HostConfig, sp
 	diagnostics := diag.Diagnostics{}
 
 	// checking if we have enough resources for this change
+	hardwareInfo, hardwareDiag := GetAvailableResources(ctx, hostConfig, arch)
+	if hardwareDiag.HasError() {
+		diagnostics.Append(hardwareDiag...)
+		return diagnostics
+	}
+
+	diagnostics.Append(CheckIfEnoughResources(hardwareInfo, specs)...)
+	return diagnostics
+}
+
+// GetAvailableResources returns the resources of the host, or of the orchestrator hosts with the
+// given architecture
+func GetAvailableResources(ctx context.Context, hostConfig apiclient.HostConfig, arch string) (*apimodels.SystemUsageResponse, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
 	var hardwareInfo *apimodels.SystemUsageResponse
 	var diag diag.Diagnostics
 
@@ -29,7 +44,7 @@ func CheckIfEnoughSpecs(ctx context.Context, hostConfig apiclient.HostConfig, sp
 		orchestratorResources, orchestratorDiag := apiclient.GetOrchestratorResources(ctx, hostConfig)
 		if orchestratorDiag.HasError() {
 			diagnostics.Append(orchestratorDiag...)
-			return diagnostics
+			return nil, diagnostics
 		}
 
 		foundArchitectureResources := false
@@ -42,25 +57,28 @@ func CheckIfEnoughSpecs(ctx context.Context, hostConfig apiclient.HostConfig, sp
 		}
 		if !foundArchitectureResources {
 			diagnostics.AddError("Hardware", fmt.Sprintf("Did not find any hosts for %s architecture in the orchestrator, please check if you have any online", arch))
-			return diagnostics
+			return nil, diagnostics
 		}
 	} else {
 		hardwareInfo, diag = apiclient.GetSystemUsage(ctx, hostConfig)
 		if diag.HasError() {
 			diagnostics.Append(diag...)
-			return diagnostics
+			return nil, diagnostics
 		}
 	}
 
 	if hardwareInfo == nil {
-		if diagnostics.HasError() {
-			return diagnostics
-		} else {
-			diagnostics.AddError("error getting hardware info", "error getting hardware info, hardware info is nil")
-		}
-		return diagnostics
+		diagnostics.AddError("error getting hardware info", "error getting hardware info, hardware info is nil")
+		return nil, diagnostics
 	}
 
+	return hardwareInfo, diagnostics
+}
+
+// CheckIfEnoughResources checks the available resources are enough for the specs
+func CheckIfEnoughResources(hardwareInfo *apimodels.SystemUsageResponse, specs *vmspecs.VmSpecs) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
 	var updateCpuValueInt int
 	var updateMemoryValueInt int
 	var err error
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CheckVmUpdatePlan is used while planning an update of a machine, it lets the user know if the
+// machine will need to be stopped to apply the changes and if the host has enough resources for the new specs
+func CheckVmUpdatePlan(ctx context.Context, hostConfig apiclient.HostConfig, vmId string, requireShutdown bool, forceChanges bool, planSpecs, stateSpecs *vmspecs.VmSpecs, arch string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	specsChanges := planSpecs != nil && SpecsBlockHasChanges(ctx, hostConfig, nil, planSpecs, stateSpecs)
+	if !requireShutdown && !specsChanges {
+		return diagnostics
+	}
+
+	// the host can be unreachable or not deployed yet while planning, the apply checks it again
+	vm, vmDiag := apiclient.GetVm(ctx, hostConfig, vmId)
+	if vmDiag.HasError() {
+		diagnostics.AddWarning("Could not check the machine update", "The machine "+vmId+" could not be read from the host, if it needs to be stopped or the host does not have enough resources it will only be detected when applying")
+		return diagnostics
+	}
+
+	// the machine is gone, the read will take care of removing it from the state
+	if vm == nil {
+		return diagnostics
+	}
+
+	if requireShutdown && vm.State != "stopped" {
+		if forceChanges {
+			diagnostics.AddWarning("Virtual Machine will be restarted", "Virtual Machine "+vm.Name+" is currently "+vm.State+" and will be stopped to apply the changes, it will be started again once the update is finished")
+		} else {
+			diagnostics.AddWarning("Virtual Machine must be stopped before updating", "Virtual Machine "+vm.Name+" is currently "+vm.State+" and the changes need it stopped, the apply will fail unless the machine is stopped first or force_changes is set to true")
+		}
+	}
+
+	if specsChanges {
+		// the machine is already using its current specs, we only need to check for the increase
+		increase := &vmspecs.VmSpecs{
+			CpuCount:   types.StringValue("0"),
+			MemorySize: types.StringValue("0"),
+		}
+		if cpuCount, err := strconv.ParseInt(planSpecs.CpuCount.ValueString(), 10, 64); err == nil && cpuCount > vm.Hardware.CPU.Cpus {
+			increase.CpuCount = types.StringValue(strconv.FormatInt(cpuCount-vm.Hardware.CPU.Cpus, 10))
+		}
+		currentMemory, _ := strconv.ParseInt(strings.ReplaceAll(vm.Hardware.Memory.Size, "Mb", ""), 10, 64)
+		if memorySize, err := strconv.ParseInt(planSpecs.MemorySize.ValueString(), 10, 64); err == nil && memorySize > currentMemory {
+			increase.MemorySize = types.StringValue(strconv.FormatInt(memorySize-currentMemory, 10))
+		}
+
+		if increase.CpuCount.ValueString() != "0" || increase.MemorySize.ValueString() != "0" {
+			hardwareInfo, hardwareDiag := GetAvailableResources(ctx, hostConfig, arch)
+			if hardwareDiag.HasError() {
+				diagnostics.AddWarning("Could not check the host resources", "The resources of the host could not be read, if it does not have enough resources for the new specs it will only be detected when applying")
+				return diagnostics
+			}
+			if specsDiag := CheckIfEnoughResources(hardwareInfo, increase); specsDiag.HasError() {
+				diagnostics.Append(specsDiag...)
+				return diagnostics
+			}
+		}
+	}
+
+	return diagnostics
+}
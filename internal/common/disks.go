@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/vmdisk"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func DisksBlockOnCreate(ctx context.Context, hostConfig apiclient.HostConfig, vm *apimodels.VirtualMachine, planDisks []*vmdisk.VmDisk) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if len(planDisks) == 0 {
+		return diagnostics
+	}
+
+	refreshVm, diag := EnsureMachineStopped(ctx, hostConfig, vm)
+	if diag.HasError() {
+		diagnostics.Append(diag...)
+		return diagnostics
+	}
+
+	for _, disk := range planDisks {
+		if diskDiag := disk.Apply(ctx, hostConfig, *refreshVm); diskDiag.HasError() {
+			diagnostics.Append(diskDiag...)
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+)
+
+// FilterOrchestratorHosts returns the hosts matching the architecture, state and containing all the tags,
+// empty filters match every host
+func FilterOrchestratorHosts(hosts []apimodels.OrchestratorHost, architecture string, state string, tags []string) []apimodels.OrchestratorHost {
+	result := make([]apimodels.OrchestratorHost, 0)
+	for _, host := range hosts {
+		if architecture != "" && !IsSameArchitecture(host.Architecture, architecture) {
+			continue
+		}
+		if state != "" && !strings.EqualFold(host.State, state) {
+			continue
+		}
+
+		hasTags := true
+		for _, tag := range tags {
+			found := false
+			for _, hostTag := range host.Tags {
+				if strings.EqualFold(hostTag, tag) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				hasTags = false
+				break
+			}
+		}
+
+		if hasTags {
+			result = append(result, host)
+		}
+	}
+
+	return result
+}
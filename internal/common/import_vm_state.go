@@ -0,0 +1,40 @@
+package common
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ImportVmState sets the host or orchestrator, credentials and machine id or name from the import
+// id, the resource Read is then responsible for rebuilding the rest of the state from the machine
+func ImportVmState(ctx context.Context, provider *models.ParallelsProviderModel, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defaultHost := ""
+	defaultOrchestrator := ""
+	if provider != nil {
+		defaultHost = provider.DefaultHost.ValueString()
+		defaultOrchestrator = provider.DefaultOrchestrator.ValueString()
+	}
+
+	importId, err := ParseVmImportId(req.ID, defaultHost, defaultOrchestrator)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	if importId.Orchestrator != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("orchestrator"), importId.Orchestrator)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), importId.Host)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId.ID)...)
+	if importId.Authenticator != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), importId.Authenticator)...)
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, planmodifiers.ImportedPrivateStateValue)...)
+}
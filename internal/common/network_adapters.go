@@ -0,0 +1,70 @@
+package common
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/vmnetwork"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func NetworkAdaptersBlockOnCreate(ctx context.Context, hostConfig apiclient.HostConfig, vm *apimodels.VirtualMachine, planAdapters []*vmnetwork.VmNetworkAdapter) diag.Diagnostics {
+	return NetworkAdaptersBlockOnUpdate(ctx, hostConfig, vm, planAdapters, nil)
+}
+
+func NetworkAdaptersBlockOnUpdate(ctx context.Context, hostConfig apiclient.HostConfig, vm *apimodels.VirtualMachine, planAdapters, stateAdapters []*vmnetwork.VmNetworkAdapter) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if !NetworkAdaptersBlockHasChanges(ctx, planAdapters, stateAdapters) {
+		return diagnostics
+	}
+
+	refreshVm, diag := EnsureMachineStopped(ctx, hostConfig, vm)
+	if diag.HasError() {
+		diagnostics.Append(diag...)
+		return diagnostics
+	}
+
+	// the machine is always created with a default adapter, so the first one is always there
+	existingCount := len(stateAdapters)
+	if existingCount == 0 {
+		existingCount = 1
+	}
+
+	for i, adapter := range planAdapters {
+		if i < len(stateAdapters) && adapter.Equals(stateAdapters[i]) {
+			continue
+		}
+
+		if adapterDiag := adapter.Apply(ctx, hostConfig, *refreshVm, i, i >= existingCount); adapterDiag.HasError() {
+			diagnostics.Append(adapterDiag...)
+			return diagnostics
+		}
+	}
+
+	// removing from the end so the remaining adapters keep their index
+	for i := len(stateAdapters) - 1; i >= len(planAdapters) && i > 0; i-- {
+		if removeDiag := vmnetwork.Remove(ctx, hostConfig, *refreshVm, i); removeDiag.HasError() {
+			diagnostics.Append(removeDiag...)
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+func NetworkAdaptersBlockHasChanges(ctx context.Context, planAdapters, stateAdapters []*vmnetwork.VmNetworkAdapter) bool {
+	if len(planAdapters) != len(stateAdapters) {
+		return true
+	}
+
+	for i, adapter := range planAdapters {
+		if !adapter.Equals(stateAdapters[i]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,73 +2,250 @@ package common
 
 import (
 	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/helpers"
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// connection string parameters understood by the provider, any other parameter is kept
+// in the connection string for the DevOps service to use
+const (
+	ConnectionStringParamTlsSkipVerify = "tls_skip_verify"
+	ConnectionStringParamCaCertificate = "ca_cert"
+	ConnectionStringParamApiPrefix     = "api_prefix"
+	ConnectionStringParamPort          = "port"
+)
+
+// ParseHostConnectionString parses a connection string in the format
+// [host=]username:password@[scheme://]host[:port][?param=value&...], use api_key as the username to
+// authenticate with an api key. Credentials containing special characters like @, : or ? need to be
+// url escaped. The supported parameters are tls_skip_verify, ca_cert, api_prefix and port
 func ParseHostConnectionString(connStr string) (*apiclient.HostConfig, error) {
 	// Remove "host=" prefix if present
-	connStr = strings.TrimPrefix(connStr, "host=")
+	connStr = strings.TrimPrefix(strings.TrimSpace(connStr), "host=")
+	if connStr == "" {
+		return nil, errors.New("the connection string is empty")
+	}
 
-	// Split at '@' to separate credentials and host
+	// Split at the last '@' to separate credentials and host, the host cannot contain one
 	atIndex := strings.LastIndex(connStr, "@")
 	if atIndex == -1 {
-		return nil, errors.New("invalid connection string format")
+		return nil, errors.New("missing credentials, the connection string needs to be in the format username:password@host or api_key:key@host")
 	}
 
 	credentials := connStr[:atIndex]
 	host := connStr[atIndex+1:]
 
 	// Split host and parameters
-	hostParts := strings.SplitN(host, "?", 2)
-	host = hostParts[0]
-
-	var params map[string]string
-	if len(hostParts) == 2 {
-		params = make(map[string]string)
-		paramStr := hostParts[1]
-		paramPairs := strings.Split(paramStr, "&")
-		for _, pair := range paramPairs {
-			keyValue := strings.SplitN(pair, "=", 2)
-			if len(keyValue) == 2 {
-				params[keyValue[0]] = keyValue[1]
-			}
+	var rawParams string
+	if hostParts := strings.SplitN(host, "?", 2); len(hostParts) == 2 {
+		host = hostParts[0]
+		rawParams = hostParts[1]
+	}
+
+	errs := make([]error, 0)
+
+	params := parseConnectionStringParams(rawParams)
+
+	authentication, err := parseConnectionStringCredentials(credentials)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	hostConfig := &apiclient.HostConfig{
+		Authorization: authentication,
+	}
+
+	hostUrl, err := parseConnectionStringHost(host, params.Get(ConnectionStringParamPort))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	hostConfig.Host = hostUrl
+
+	if value := params.Get(ConnectionStringParamTlsSkipVerify); value != "" {
+		skipVerify, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s value %q, it needs to be true or false", ConnectionStringParamTlsSkipVerify, value))
+		}
+		hostConfig.DisableTlsValidation = skipVerify
+	}
+
+	if value := params.Get(ConnectionStringParamCaCertificate); value != "" {
+		if _, err := helpers.LoadCaCertificate(value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s value: %v", ConnectionStringParamCaCertificate, err))
+		}
+		hostConfig.CaCertificate = value
+	}
+
+	if value := params.Get(ConnectionStringParamApiPrefix); value != "" {
+		if strings.ContainsAny(value, "?#@ ") {
+			errs = append(errs, fmt.Errorf("invalid %s value %q, it can only contain a path like /devops/api", ConnectionStringParamApiPrefix, value))
+		}
+		hostConfig.ApiPrefix = helpers.GetHostApiPrefix(value)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return hostConfig, nil
+}
+
+// GetServiceConnectionString returns the connection string sent to the DevOps service, the
+// credentials are unescaped and the parameters only the provider understands are removed, any
+// other parameter is kept as it is
+func GetServiceConnectionString(connStr string) (string, error) {
+	if _, err := ParseHostConnectionString(connStr); err != nil {
+		return "", err
+	}
+
+	connStr = strings.TrimSpace(connStr)
+	prefix := ""
+	if strings.HasPrefix(connStr, "host=") {
+		prefix = "host="
+		connStr = strings.TrimPrefix(connStr, "host=")
+	}
+
+	atIndex := strings.LastIndex(connStr, "@")
+	credentials := connStr[:atIndex]
+	host, rawParams, _ := strings.Cut(connStr[atIndex+1:], "?")
+
+	credIndex := strings.Index(credentials, ":")
+	username := unescapeConnectionStringValue(credentials[:credIndex])
+	password := unescapeConnectionStringValue(credentials[credIndex+1:])
+
+	params := make([]string, 0)
+	for _, param := range strings.Split(rawParams, "&") {
+		if param == "" {
+			continue
+		}
+
+		key, _, _ := strings.Cut(param, "=")
+		switch unescapeConnectionStringValue(key) {
+		case ConnectionStringParamTlsSkipVerify, ConnectionStringParamCaCertificate, ConnectionStringParamApiPrefix:
+			continue
 		}
+		params = append(params, param)
+	}
+
+	serviceConnStr := prefix + username + ":" + password + "@" + host
+	if len(params) > 0 {
+		serviceConnStr += "?" + strings.Join(params, "&")
 	}
-	host = strings.ToLower(host)
-	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
-		host = "https://" + host
+
+	return serviceConnStr, nil
+}
+
+// parseConnectionStringParams parses the connection string parameters, unlike url.ParseQuery a +
+// is kept as it is so base64 values like ca_cert do not need to be escaped
+func parseConnectionStringParams(rawParams string) url.Values {
+	params := url.Values{}
+	for _, param := range strings.Split(rawParams, "&") {
+		if param == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(param, "=")
+		params.Add(unescapeConnectionStringValue(key), unescapeConnectionStringValue(value))
 	}
 
-	// Split credentials at ':' to get username and password
+	return params
+}
+
+// unescapeConnectionStringValue url unescapes a value of the connection string, values that are
+// not valid escapes like passwords with a literal % are used as they are
+func unescapeConnectionStringValue(value string) string {
+	unescaped, err := url.PathUnescape(value)
+	if err != nil {
+		return value
+	}
+
+	return unescaped
+}
+
+func parseConnectionStringCredentials(credentials string) (*authenticator.Authentication, error) {
+	// Split credentials at the first ':' to get username and password, the username needs to be
+	// escaped if it contains one
 	credIndex := strings.Index(credentials, ":")
 	if credIndex == -1 {
-		return nil, errors.New("invalid credentials format")
+		return nil, errors.New("invalid credentials, they need to be in the format username:password or api_key:key")
+	}
+
+	username := unescapeConnectionStringValue(credentials[:credIndex])
+	password := unescapeConnectionStringValue(credentials[credIndex+1:])
+
+	if username == "" {
+		return nil, errors.New("the username cannot be empty")
+	}
+	if password == "" {
+		if strings.EqualFold(username, "api_key") {
+			return nil, errors.New("the api key cannot be empty")
+		}
+		return nil, errors.New("the password cannot be empty")
 	}
-	username := credentials[:credIndex]
-	password := credentials[credIndex+1:]
 
-	var authentication authenticator.Authentication
 	if strings.EqualFold(username, "api_key") {
-		authentication = authenticator.Authentication{
+		return &authenticator.Authentication{
 			ApiKey: types.StringValue(password),
-		}
-	} else {
-		authentication = authenticator.Authentication{
-			Username: types.StringValue(username),
-			Password: types.StringValue(password),
-		}
+		}, nil
 	}
 
-	// Populate the HostConfig
-	hostConfig := &apiclient.HostConfig{
-		Host:          host,
-		Authorization: &authentication,
+	return &authenticator.Authentication{
+		Username: types.StringValue(username),
+		Password: types.StringValue(password),
+	}, nil
+}
+
+func parseConnectionStringHost(host string, port string) (string, error) {
+	if host == "" {
+		return "", errors.New("the host cannot be empty")
 	}
 
-	return hostConfig, nil
+	scheme := "https"
+	if schemeIndex := strings.Index(host, "://"); schemeIndex != -1 {
+		scheme = strings.ToLower(host[:schemeIndex])
+		host = host[schemeIndex+3:]
+	}
+	if scheme != "http" && scheme != "https" {
+		return "", fmt.Errorf("unsupported scheme %s, it needs to be http or https", scheme)
+	}
+
+	host = strings.TrimSuffix(host, "/")
+	if strings.Contains(host, "/") {
+		return "", fmt.Errorf("the host %s cannot contain a path, use the %s parameter instead", host, ConnectionStringParamApiPrefix)
+	}
+
+	hostname := strings.Trim(host, "[]")
+	hostPort := ""
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+		hostPort = p
+	}
+	if hostname == "" {
+		return "", errors.New("the host cannot be empty")
+	}
+
+	if port != "" {
+		if hostPort != "" && hostPort != port {
+			return "", fmt.Errorf("the host port %s does not match the %s parameter %s", hostPort, ConnectionStringParamPort, port)
+		}
+		hostPort = port
+	}
+	if hostPort != "" {
+		if portNumber, err := strconv.Atoi(hostPort); err != nil || portNumber < 1 || portNumber > 65535 {
+			return "", fmt.Errorf("invalid port %s, it needs to be a number between 1 and 65535", hostPort)
+		}
+		host = net.JoinHostPort(hostname, hostPort)
+	}
+
+	// only the hostname is case insensitive
+	return scheme + "://" + strings.ToLower(host), nil
 }
@@ -0,0 +1,279 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseHostConnectionString(t *testing.T) {
+	caCert := newTestCaCertificate(t)
+
+	tests := []struct {
+		name           string
+		connStr        string
+		wantHost       string
+		wantUsername   string
+		wantPassword   string
+		wantApiKey     string
+		wantApiPrefix  string
+		wantSkipVerify bool
+		wantCaCert     string
+		wantErr        bool
+	}{
+		{
+			name:         "username and password",
+			connStr:      "user:pass@example.com",
+			wantHost:     "https://example.com",
+			wantUsername: "user",
+			wantPassword: "pass",
+		},
+		{
+			name:         "host prefix and scheme",
+			connStr:      "host=user:pass@http://Example.com:8080",
+			wantHost:     "http://example.com:8080",
+			wantUsername: "user",
+			wantPassword: "pass",
+		},
+		{
+			name:       "api key",
+			connStr:    "api_key:secret@example.com",
+			wantHost:   "https://example.com",
+			wantApiKey: "secret",
+		},
+		{
+			name:         "escaped credentials",
+			connStr:      "us%40er:p%3Ass@example.com",
+			wantHost:     "https://example.com",
+			wantUsername: "us@er",
+			wantPassword: "p:ss",
+		},
+		{
+			name:         "password with a literal percent",
+			connStr:      "user:100%sure@example.com",
+			wantHost:     "https://example.com",
+			wantUsername: "user",
+			wantPassword: "100%sure",
+		},
+		{
+			name:         "password with a plus",
+			connStr:      "user:a+b@example.com",
+			wantHost:     "https://example.com",
+			wantUsername: "user",
+			wantPassword: "a+b",
+		},
+		{
+			name:         "port parameter",
+			connStr:      "user:pass@example.com?port=8443",
+			wantHost:     "https://example.com:8443",
+			wantUsername: "user",
+			wantPassword: "pass",
+		},
+		{
+			name:           "tls and api prefix parameters",
+			connStr:        "user:pass@example.com?tls_skip_verify=true&api_prefix=/devops/api&unknown=value",
+			wantHost:       "https://example.com",
+			wantUsername:   "user",
+			wantPassword:   "pass",
+			wantApiPrefix:  "/devops/api",
+			wantSkipVerify: true,
+		},
+		{
+			name:         "pem ca certificate keeps the plus signs",
+			connStr:      "user:pass@example.com?ca_cert=" + strings.NewReplacer("\n", "%0A", " ", "%20").Replace(caCert),
+			wantHost:     "https://example.com",
+			wantUsername: "user",
+			wantPassword: "pass",
+			wantCaCert:   caCert,
+		},
+		{
+			name:    "empty connection string",
+			connStr: "",
+			wantErr: true,
+		},
+		{
+			name:    "missing credentials",
+			connStr: "example.com",
+			wantErr: true,
+		},
+		{
+			name:    "missing password",
+			connStr: "user:@example.com",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			connStr: "user:pass@ftp://example.com",
+			wantErr: true,
+		},
+		{
+			name:    "host with a path",
+			connStr: "user:pass@example.com/api",
+			wantErr: true,
+		},
+		{
+			name:    "mismatched port",
+			connStr: "user:pass@example.com:8080?port=8443",
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			connStr: "user:pass@example.com?port=70000",
+			wantErr: true,
+		},
+		{
+			name:    "invalid tls_skip_verify",
+			connStr: "user:pass@example.com?tls_skip_verify=maybe",
+			wantErr: true,
+		},
+		{
+			name:    "invalid ca certificate",
+			connStr: "user:pass@example.com?ca_cert=not-a-certificate",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHostConnectionString(tt.connStr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Host != tt.wantHost {
+				t.Errorf("host = %q, want %q", got.Host, tt.wantHost)
+			}
+			if got.Authorization.Username.ValueString() != tt.wantUsername {
+				t.Errorf("username = %q, want %q", got.Authorization.Username.ValueString(), tt.wantUsername)
+			}
+			if got.Authorization.Password.ValueString() != tt.wantPassword {
+				t.Errorf("password = %q, want %q", got.Authorization.Password.ValueString(), tt.wantPassword)
+			}
+			if got.Authorization.ApiKey.ValueString() != tt.wantApiKey {
+				t.Errorf("api key = %q, want %q", got.Authorization.ApiKey.ValueString(), tt.wantApiKey)
+			}
+			if got.ApiPrefix != tt.wantApiPrefix {
+				t.Errorf("api prefix = %q, want %q", got.ApiPrefix, tt.wantApiPrefix)
+			}
+			if got.DisableTlsValidation != tt.wantSkipVerify {
+				t.Errorf("disable tls validation = %v, want %v", got.DisableTlsValidation, tt.wantSkipVerify)
+			}
+			if got.CaCertificate != tt.wantCaCert {
+				t.Errorf("ca certificate = %q, want %q", got.CaCertificate, tt.wantCaCert)
+			}
+		})
+	}
+}
+
+// newTestCaCertificate returns a PEM encoded self signed certificate that contains a + so the
+// tests cover it not being turned into a space
+func newTestCaCertificate(t *testing.T) string {
+	t.Helper()
+
+	for i := int64(1); i <= 20; i++ {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("could not generate the key: %v", err)
+		}
+
+		template := x509.Certificate{
+			SerialNumber:          big.NewInt(i),
+			Subject:               pkix.Name{CommonName: "test ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+		if err != nil {
+			t.Fatalf("could not create the certificate: %v", err)
+		}
+
+		encoded := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		if strings.Contains(encoded, "+") {
+			return encoded
+		}
+	}
+
+	t.Fatal("could not generate a certificate with a + in its PEM encoding")
+	return ""
+}
+
+func TestGetServiceConnectionString(t *testing.T) {
+	// the ca certificate file only exists in the machine running terraform
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(newTestCaCertificate(t)), 0o600); err != nil {
+		t.Fatalf("could not write the ca certificate: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		connStr string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "plain connection string",
+			connStr: "user:pass@example.com",
+			want:    "user:pass@example.com",
+		},
+		{
+			name:    "host prefix and scheme are kept",
+			connStr: "host=api_key:secret@http://example.com:8080",
+			want:    "host=api_key:secret@http://example.com:8080",
+		},
+		{
+			name:    "escaped credentials are unescaped",
+			connStr: "us%40er:p%3Ass@example.com",
+			want:    "us@er:p:ss@example.com",
+		},
+		{
+			name:    "provider parameters are removed",
+			connStr: "user:pass@example.com?tls_skip_verify=true&api_prefix=/devops/api&ca_cert=" + caCertFile,
+			want:    "user:pass@example.com",
+		},
+		{
+			name:    "other parameters are kept",
+			connStr: "user:pass@example.com?port=8443&tls_skip_verify=true&provider=aws-s3&region=eu%2Dwest",
+			want:    "user:pass@example.com?port=8443&provider=aws-s3&region=eu%2Dwest",
+		},
+		{
+			name:    "invalid connection string",
+			connStr: "example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetServiceConnectionString(tt.connStr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("connection string = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
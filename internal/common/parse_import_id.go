@@ -0,0 +1,125 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VmImportOrchestratorPrefix marks a machine import id that targets an orchestrator instead of a host
+const VmImportOrchestratorPrefix = "orchestrator/"
+
+// VmImportId is a parsed machine import id, only one of Host or Orchestrator is set
+type VmImportId struct {
+	Host          string
+	Orchestrator  string
+	Authenticator *authenticator.Authentication
+	ID            string
+}
+
+// ParseVmImportId parses a machine import id in the format [orchestrator/][[username:password@]host@]id_or_name
+// the credentials can also be an api key in the format api_key:key. When the host is not part of
+// the import id the default host or orchestrator is used instead
+func ParseVmImportId(importId string, defaultHost string, defaultOrchestrator string) (*VmImportId, error) {
+	isOrchestrator := strings.HasPrefix(importId, VmImportOrchestratorPrefix)
+	if isOrchestrator {
+		importId = strings.TrimPrefix(importId, VmImportOrchestratorPrefix)
+		defaultHost = ""
+	}
+
+	result := VmImportId{}
+	if !strings.Contains(importId, "@") {
+		if importId == "" {
+			return nil, errors.New("the import id is missing the machine id or name")
+		}
+
+		switch {
+		case defaultHost != "":
+			result.Host = defaultHost
+		case defaultOrchestrator != "":
+			result.Orchestrator = defaultOrchestrator
+		default:
+			return nil, errors.New("the import id needs to include the host when the provider has no default_host or default_orchestrator, expected format is [orchestrator/][[username:password@]host@]id_or_name")
+		}
+
+		result.ID = importId
+		return &result, nil
+	}
+
+	host, auth, machineId, err := ParseImportId(importId, "id_or_name", "machine id or name")
+	if err != nil {
+		return nil, err
+	}
+
+	if isOrchestrator {
+		result.Orchestrator = host
+	} else {
+		result.Host = host
+	}
+	result.Authenticator = auth
+	result.ID = machineId
+	return &result, nil
+}
+
+// ParseImportId parses an import id in the format [username:password@]host@<idFormat>, the
+// credentials can also be an api key in the format api_key:key
+func ParseImportId(importId string, idFormat string, idName string) (string, *authenticator.Authentication, string, error) {
+	atIndex := strings.LastIndex(importId, "@")
+	if atIndex == -1 {
+		return "", nil, "", fmt.Errorf("the import id needs to include the host, expected format is [username:password@]host@%s", idFormat)
+	}
+
+	connection := importId[:atIndex]
+	machineId := importId[atIndex+1:]
+	if machineId == "" {
+		return "", nil, "", fmt.Errorf("the import id is missing the %s", idName)
+	}
+
+	credentialsIndex := strings.LastIndex(connection, "@")
+	if credentialsIndex == -1 {
+		if connection == "" {
+			return "", nil, "", errors.New("the import id is missing the host")
+		}
+		return connection, nil, machineId, nil
+	}
+
+	credentials := connection[:credentialsIndex]
+	host := connection[credentialsIndex+1:]
+	if host == "" {
+		return "", nil, "", errors.New("the import id is missing the host")
+	}
+
+	// removing the scheme from the credentials if the host was written as a full url
+	scheme := ""
+	if schemeIndex := strings.Index(credentials, "://"); schemeIndex != -1 {
+		scheme = credentials[:schemeIndex+3]
+		credentials = credentials[schemeIndex+3:]
+		host = scheme + host
+	}
+
+	username, password, found := strings.Cut(credentials, ":")
+	if !found {
+		return "", nil, "", errors.New("invalid credentials format, expected username:password or api_key:key")
+	}
+
+	var auth authenticator.Authentication
+	if strings.EqualFold(username, "api_key") {
+		auth = authenticator.Authentication{
+			Username: types.StringNull(),
+			Password: types.StringNull(),
+			ApiKey:   types.StringValue(password),
+		}
+	} else {
+		auth = authenticator.Authentication{
+			Username: types.StringValue(username),
+			Password: types.StringValue(password),
+			ApiKey:   types.StringNull(),
+		}
+	}
+
+	return host, &auth, machineId, nil
+}
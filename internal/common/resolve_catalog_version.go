@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/constants"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ResolveCatalogVersion finds the catalog version that matches the requested version, the requested version
+// can be an exact version, latest or a version constraint like "~> 1.2" or ">= 2.0, < 3.0".
+// If the pinned version is set and still matches the request it will be returned instead of the newest one
+func ResolveCatalogVersion(ctx context.Context, catalogHostConfig apiclient.HostConfig, catalogId string, requestedVersion string, architecture string, pinnedVersion string) (string, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	manifests, manifestsDiag := apiclient.GetCatalogManifests(ctx, catalogHostConfig, catalogId)
+	if manifestsDiag.HasError() {
+		diagnostics.Append(manifestsDiag...)
+		return "", diagnostics
+	}
+
+	available := FilterCatalogManifests(manifests, architecture, nil)
+
+	// we could not list the versions, the catalog will need to resolve it
+	if len(available) == 0 {
+		if IsLatestCatalogVersion(requestedVersion) {
+			return constants.CatalogVersionLatest, diagnostics
+		}
+		return strings.TrimSpace(requestedVersion), diagnostics
+	}
+
+	manifest, err := SelectCatalogManifest(available, requestedVersion, pinnedVersion)
+	if err != nil {
+		diagnostics.AddError("Error resolving catalog version", fmt.Sprintf("Could not resolve the version of %s: %v", catalogId, err))
+		return "", diagnostics
+	}
+
+	return manifest.Version, diagnostics
+}
+
+// IsLatestCatalogVersion returns true if the requested version asks for the newest version
+func IsLatestCatalogVersion(requestedVersion string) bool {
+	requestedVersion = strings.TrimSpace(requestedVersion)
+	return requestedVersion == "" || strings.EqualFold(requestedVersion, constants.CatalogVersionLatest)
+}
+
+// FilterCatalogManifests returns the manifests built for the architecture that contain all the tags,
+// an empty architecture or no tags will not filter the manifests
+func FilterCatalogManifests(manifests []*apimodels.CatalogManifest, architecture string, tags []string) []*apimodels.CatalogManifest {
+	result := make([]*apimodels.CatalogManifest, 0)
+	for _, manifest := range manifests {
+		if manifest == nil {
+			continue
+		}
+		if architecture != "" && normalizeArchitecture(manifest.Architecture) != normalizeArchitecture(architecture) {
+			continue
+		}
+
+		hasTags := true
+		for _, tag := range tags {
+			found := false
+			for _, manifestTag := range manifest.Tags {
+				if strings.EqualFold(manifestTag, tag) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				hasTags = false
+				break
+			}
+		}
+
+		if hasTags {
+			result = append(result, manifest)
+		}
+	}
+
+	return result
+}
+
+// MatchesCatalogVersion returns true if the manifest version is the requested version or
+// satisfies the requested version constraint
+func MatchesCatalogVersion(manifest *apimodels.CatalogManifest, requestedVersion string) (bool, error) {
+	requestedVersion = strings.TrimSpace(requestedVersion)
+	if IsLatestCatalogVersion(requestedVersion) || manifest.Version == requestedVersion {
+		return true, nil
+	}
+
+	constraints, err := version.NewConstraint(requestedVersion)
+	if err != nil {
+		return false, fmt.Errorf("%s is not a valid version or version constraint: %w", requestedVersion, err)
+	}
+
+	manifestVersion, err := version.NewVersion(manifest.Version)
+	if err != nil {
+		return false, nil
+	}
+
+	return constraints.Check(manifestVersion), nil
+}
+
+// SelectCatalogManifest selects the newest manifest matching the requested version, if the pinned
+// version is set and still matches the request that manifest is returned instead
+func SelectCatalogManifest(manifests []*apimodels.CatalogManifest, requestedVersion string, pinnedVersion string) (*apimodels.CatalogManifest, error) {
+	requestedVersion = strings.TrimSpace(requestedVersion)
+	isLatest := IsLatestCatalogVersion(requestedVersion)
+
+	if !isLatest {
+		for _, manifest := range manifests {
+			if manifest.Version == requestedVersion {
+				return manifest, nil
+			}
+		}
+	}
+
+	var resolved *apimodels.CatalogManifest
+	var resolvedVersion *version.Version
+	for _, manifest := range manifests {
+		matches, err := MatchesCatalogVersion(manifest, requestedVersion)
+		if err != nil {
+			return nil, err
+		}
+		manifestVersion, err := version.NewVersion(manifest.Version)
+		if err != nil || !matches {
+			continue
+		}
+		if pinnedVersion != "" && manifest.Version == pinnedVersion {
+			return manifest, nil
+		}
+		if resolvedVersion == nil || manifestVersion.GreaterThan(resolvedVersion) {
+			resolved = manifest
+			resolvedVersion = manifestVersion
+		}
+	}
+
+	// none of the versions follow semantic versioning, the newest one is the latest
+	if resolved == nil && isLatest {
+		for _, manifest := range manifests {
+			if pinnedVersion != "" && manifest.Version == pinnedVersion {
+				return manifest, nil
+			}
+			if resolved == nil || manifest.CreatedAt.After(resolved.CreatedAt) {
+				resolved = manifest
+			}
+		}
+	}
+
+	if resolved == nil {
+		return nil, fmt.Errorf("did not find any version matching %s", requestedVersion)
+	}
+
+	return resolved, nil
+}
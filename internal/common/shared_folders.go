@@ -126,3 +126,27 @@ func SharedFoldersBlockOnUpdate(ctx context.Context, hostConfig apiclient.HostCo
 
 	return diagnostics
 }
+
+// SharedFoldersBlockHasChanges returns true if any shared folder was added, removed or changed,
+// the folders are matched by name as their order does not matter to the machine
+func SharedFoldersBlockHasChanges(ctx context.Context, planSharedFolder, stateSharedFolder []*sharedfolder.SharedFolder) bool {
+	if len(planSharedFolder) != len(stateSharedFolder) {
+		return true
+	}
+
+	for _, sharedFolder := range planSharedFolder {
+		var currentSharedFolder *sharedfolder.SharedFolder
+		for _, current := range stateSharedFolder {
+			if current.Name.ValueString() == sharedFolder.Name.ValueString() {
+				currentSharedFolder = current
+				break
+			}
+		}
+
+		if !sharedFolder.Equals(currentSharedFolder) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -76,7 +76,7 @@ func SpecsBlockOnUpdate(ctx context.Context, hostConfig apiclient.HostConfig, vm
 	}
 
 	if planSpecs.MemorySize.ValueString() != "" && planSpecs.MemorySize.ValueString() != strings.ReplaceAll(vm.Hardware.Memory.Size, "Mb", "") {
-		updateValue := planSpecs.CpuCount.ValueString()
+		updateValue := planSpecs.MemorySize.ValueString()
 		if updateValue == "" {
 			updateValue = "2048"
 		}
@@ -3,14 +3,38 @@ package constants
 const (
 	API_PREFIX_VERSION = "/api/v1"
 	API_PREFIX         = "/api"
+	API_VERSION        = "v1"
 	RootUser           = "root@localhost"
 	DefaultApiPort     = "8080"
 	FILTER_HEADER      = "X-Filter"
 )
 
+// SupportedApiVersions are the DevOps api versions the provider can use, from the oldest to the newest
+var SupportedApiVersions = []string{API_VERSION}
+
 const (
 	DEFAULT_SCRIPT_MAX_RETRY_COUNT              = 4
 	DEFAULT_SCRIPT_RETRY_INTERVAL_IN_SECONDS    = 30
 	DEFAULT_OPERATION_MAX_RETRY_COUNT           = 20
 	DEFAULT_OPERATION_RETRY_INTERVAL_IN_SECONDS = 10
+	DEFAULT_SSH_TUNNEL_IDLE_TIMEOUT_IN_SECONDS  = 60
+)
+
+const (
+	CatalogVersionLatest      = "latest"
+	UpgradePolicyPin          = "pin"
+	UpgradePolicyOnNewVersion = "on_new_version"
+)
+
+const (
+	ReverseProxyLoadBalancingRoundRobin       = "round_robin"
+	ReverseProxyLoadBalancingLeastConnections = "least_connections"
+	ReverseProxyHealthCheckHttp               = "http"
+	ReverseProxyHealthCheckTcp                = "tcp"
+)
+
+const (
+	HostDrainVmActionNone    = "none"
+	HostDrainVmActionStop    = "stop"
+	HostDrainVmActionMigrate = "migrate"
 )
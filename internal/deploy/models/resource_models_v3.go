@@ -166,6 +166,7 @@ func (o *DeployResourceModelV3) GenerateApiHostConfig(provider *models.Parallels
 	}
 
 	hostConfig.Host = api_schema + "://" + hostConfig.Host
+	hostConfig.ApiPrefix = o.ApiConfig.Prefix.ValueString()
 
 	return hostConfig
 }
@@ -565,7 +565,7 @@ func (r *DeployResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	} else if currentData.Orchestrator != nil {
 		if currentData.Orchestrator.HostId.ValueString() != "" {
-			if diag := orchestrator.UnregisterWithHost(ctx, *currentData.Orchestrator, r.provider.DisableTlsValidation.ValueBool()); diag.HasError() {
+			if diag := orchestrator.UnregisterWithHost(ctx, *currentData.Orchestrator, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString()); diag.HasError() {
 				resp.Diagnostics.Append(diag...)
 				return
 			}
@@ -1059,6 +1059,9 @@ func (r *DeployResource) registerWithOrchestrator(ctx context.Context, data, cur
 
 	if currentData != nil {
 		currentRegistration := *currentData.Orchestrator
+		if currentData.ApiConfig != nil {
+			currentRegistration.ApiPrefix = currentData.ApiConfig.Prefix.ValueString()
+		}
 		if common.GetString(currentData.OrchestratorHostId) != "" {
 			currentRegistration.HostId = currentData.OrchestratorHostId
 		}
@@ -1069,14 +1072,14 @@ func (r *DeployResource) registerWithOrchestrator(ctx context.Context, data, cur
 		}
 
 		// checking if we already registered with orchestrator
-		isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool())
+		isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString())
 		if diags.HasError() {
 			diagnostic.Append(diags...)
 			return diagnostic
 		}
 		if isRegistered {
 			currentRegistration.HostId = types.StringValue(item.ID)
-			if diag := orchestrator.UnregisterWithHost(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool()); diag.HasError() {
+			if diag := orchestrator.UnregisterWithHost(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString()); diag.HasError() {
 				diag.Append(diag...)
 				return diag
 			}
@@ -1096,9 +1099,10 @@ func (r *DeployResource) registerWithOrchestrator(ctx context.Context, data, cur
 			Password: types.StringValue(password),
 		},
 		Orchestrator: data.Orchestrator.Orchestrator,
+		ApiPrefix:    data.ApiConfig.Prefix.ValueString(),
 	}
 
-	isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, orchestratorConfig, r.provider.DisableTlsValidation.ValueBool())
+	isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, orchestratorConfig, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString())
 	if diags.HasError() {
 		diagnostic.Append(diags...)
 		data.IsRegisteredInOrchestrator = types.BoolValue(true)
@@ -1108,7 +1112,7 @@ func (r *DeployResource) registerWithOrchestrator(ctx context.Context, data, cur
 	}
 
 	if !isRegistered {
-		id, diag := orchestrator.RegisterWithHost(ctx, orchestratorConfig, r.provider.DisableTlsValidation.ValueBool())
+		id, diag := orchestrator.RegisterWithHost(ctx, orchestratorConfig, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString())
 		if diag.HasError() {
 			diagnostic.Append(diag...)
 			return diagnostic
@@ -1140,11 +1144,14 @@ func (r *DeployResource) unregisterWithOrchestrator(ctx context.Context, data *d
 	}
 
 	currentRegistration := *data.Orchestrator
+	if data.ApiConfig != nil {
+		currentRegistration.ApiPrefix = data.ApiConfig.Prefix.ValueString()
+	}
 	if common.GetString(data.OrchestratorHostId) != "" {
 		currentRegistration.HostId = data.OrchestratorHostId
 	}
 
-	isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool())
+	isRegistered, item, diags := orchestrator.IsAlreadyRegistered(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString())
 	if diags.HasError() {
 		diagnostic.Append(diags...)
 		return diagnostic
@@ -1153,7 +1160,7 @@ func (r *DeployResource) unregisterWithOrchestrator(ctx context.Context, data *d
 	if isRegistered {
 		// checking if we already registered with orchestrator
 		currentRegistration.HostId = types.StringValue(item.ID)
-		if diag := orchestrator.UnregisterWithHost(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool()); diag.HasError() {
+		if diag := orchestrator.UnregisterWithHost(ctx, currentRegistration, r.provider.DisableTlsValidation.ValueBool(), r.provider.ApiPrefix.ValueString()); diag.HasError() {
 			diag.Append(diag...)
 			return diag
 		}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"terraform-provider-parallels-desktop/internal/clientmodels"
+	"terraform-provider-parallels-desktop/internal/ssh"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,6 +37,8 @@ func (v HttpCallerVerb) String() string {
 
 type HttpCaller struct {
 	disableTlsVerification bool
+	caCertificate          string
+	sshTunnel              *ssh.TunnelConfig
 }
 
 type HttpCallerAuth struct {
@@ -54,6 +58,20 @@ func NewHttpCaller(ctx context.Context, disableTlsVerification bool) *HttpCaller
 	}
 }
 
+// WithCaCertificate makes the caller trust the given certificate authority, the certificate
+// can be a path to a PEM file, a PEM string or a base64 encoded PEM string
+func (c *HttpCaller) WithCaCertificate(caCertificate string) *HttpCaller {
+	c.caCertificate = caCertificate
+	return c
+}
+
+// WithSshTunnel makes the caller reach the api through a ssh tunnel, the request urls are kept so
+// the tls validation still uses the api host name
+func (c *HttpCaller) WithSshTunnel(config *ssh.TunnelConfig) *HttpCaller {
+	c.sshTunnel = config
+	return c
+}
+
 func (c *HttpCaller) GetDataFromClient(ctx context.Context, url string, headers *map[string]string, auth *HttpCallerAuth, destination interface{}) (*HttpCallerResponse, error) {
 	return c.RequestDataToClient(ctx, HttpCallerVerbGet, url, headers, nil, auth, destination)
 }
@@ -90,20 +108,24 @@ func (c *HttpCaller) RequestDataToClient(ctx context.Context, verb HttpCallerVer
 	}
 
 	client := http.DefaultClient
-	if c.disableTlsVerification {
+	if c.disableTlsVerification || c.caCertificate != "" || c.sshTunnel != nil {
+		transport, err := c.getTransport()
+		if err != nil {
+			return &clientResponse, err
+		}
 		client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			},
-			Timeout: 60 * time.Second,
+			Transport: transport,
+			Timeout:   60 * time.Second,
 		}
 	}
 
 	if deadline, ok := ctx.Deadline(); ok {
 		timeout := time.Until(deadline)
 		if timeout > 0 {
+			// keeping the transport so the tls settings are not lost
 			client = &http.Client{
-				Timeout: timeout,
+				Transport: client.Transport,
+				Timeout:   timeout,
 			}
 		}
 	}
@@ -194,7 +216,52 @@ func (c *HttpCaller) RequestDataToClient(ctx context.Context, verb HttpCallerVer
 	return &clientResponse, nil
 }
 
-func (c *HttpCaller) GetJwtToken(ctx context.Context, baseUrl, username, password string) (string, error) {
+func (c *HttpCaller) getTransport() (*http.Transport, error) {
+	tlsConfig, err := c.getTlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	if c.sshTunnel != nil {
+		// pooled connections would keep the tunnel busy and prevent it from closing when idle
+		transport.DisableKeepAlives = true
+		dialer := &net.Dialer{Timeout: 30 * time.Second}
+		tunnelConfig := c.sshTunnel
+		transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+			tunnel, err := AcquireSshTunnel(tunnelConfig, address)
+			if err != nil {
+				return nil, err
+			}
+			defer tunnel.Release()
+
+			return dialer.DialContext(ctx, network, tunnel.LocalAddress())
+		}
+	}
+
+	return transport, nil
+}
+
+func (c *HttpCaller) getTlsConfig() (*tls.Config, error) {
+	// #nosec G402 -- The validation is only skipped when the user explicitly disables it
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.disableTlsVerification}
+	if c.caCertificate == "" {
+		return tlsConfig, nil
+	}
+
+	certPool, err := LoadCaCertificate(c.caCertificate)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.RootCAs = certPool
+
+	return tlsConfig, nil
+}
+
+func (c *HttpCaller) GetJwtToken(ctx context.Context, apiBaseUrl, username, password string) (string, error) {
 	if username == "" {
 		return "", errors.New("username cannot be empty")
 	}
@@ -208,10 +275,10 @@ func (c *HttpCaller) GetJwtToken(ctx context.Context, baseUrl, username, passwor
 		Password: password,
 	}
 
-	tflog.Info(ctx, "Getting token from %s"+baseUrl+"/api/v1/auth/token with username"+username+" and password"+password+"")
+	tflog.Info(ctx, "Getting token from "+apiBaseUrl+"/auth/token with username "+username)
 
 	var tokenResponse clientmodels.TokenLoginResponse
-	if _, err := c.PostDataToClient(ctx, baseUrl+"/api/v1/auth/token", nil, tokenRequest, nil, &tokenResponse); err != nil {
+	if _, err := c.PostDataToClient(ctx, apiBaseUrl+"/auth/token", nil, tokenRequest, nil, &tokenResponse); err != nil {
 		return "", err
 	}
 	return tokenResponse.Token, nil
@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/constants"
+	"terraform-provider-parallels-desktop/internal/ssh"
+)
+
+var (
+	sshTunnels      = map[string]*ssh.SshTunnel{}
+	sshTunnelsMutex sync.Mutex
+)
+
+// AcquireSshTunnel returns a tunnel forwarding to the target address, the tunnel is opened on the
+// first use and reused until it closes after being idle. The tunnel is acquired so it cannot close
+// before the caller connects through it, the caller must release it once connected
+func AcquireSshTunnel(config *ssh.TunnelConfig, targetAddress string) (*ssh.SshTunnel, error) {
+	targetHost, targetPort, err := net.SplitHostPort(targetAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sshHost := config.Host
+	if sshHost == "" {
+		sshHost = targetHost
+	}
+	sshPort := config.Port
+	if sshPort == "" {
+		sshPort = "22"
+	}
+	remoteHost := config.RemoteHost
+	if remoteHost == "" {
+		remoteHost = "127.0.0.1"
+	}
+	remoteAddress := net.JoinHostPort(remoteHost, targetPort)
+
+	key := fmt.Sprintf("%s@%s->%s", config.User, net.JoinHostPort(sshHost, sshPort), remoteAddress)
+
+	sshTunnelsMutex.Lock()
+	defer sshTunnelsMutex.Unlock()
+
+	if tunnel, ok := sshTunnels[key]; ok && tunnel.Acquire() {
+		return tunnel, nil
+	}
+
+	client, err := ssh.NewSshClient(sshHost, sshPort, ssh.SshAuthorization{
+		User:       config.User,
+		Password:   config.Password,
+		PrivateKey: config.PrivateKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating the ssh tunnel to %s: %v", sshHost, err)
+	}
+
+	tunnel, err := client.OpenTunnel(remoteAddress, constants.DEFAULT_SSH_TUNNEL_IDLE_TIMEOUT_IN_SECONDS*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("error opening the ssh tunnel to %s: %v", sshHost, err)
+	}
+
+	if !tunnel.Acquire() {
+		return nil, fmt.Errorf("the ssh tunnel to %s closed before it could be used", sshHost)
+	}
+
+	sshTunnels[key] = tunnel
+	return tunnel, nil
+}
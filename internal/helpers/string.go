@@ -1,11 +1,13 @@
 package helpers
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -21,6 +23,22 @@ func Base64Encode(input string) string {
 	return base64.StdEncoding.EncodeToString([]byte(input))
 }
 
+// RandomString returns a random alphanumeric string of the given length, it is used to generate
+// secrets so it reads from the crypto random source
+func RandomString(length int) (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	result := make([]byte, length)
+	for i := range result {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			return "", err
+		}
+		result[i] = alphabet[index.Int64()]
+	}
+
+	return string(result), nil
+}
+
 func Base64Decode(input string) (string, error) {
 	b, err := base64.StdEncoding.DecodeString(input)
 	if err != nil {
@@ -50,6 +68,44 @@ func GetHostApiVersionedBaseUrl(host string) string {
 	return strings.TrimSuffix(GetHostUrl(host)+constants.API_PREFIX_VERSION, "/")
 }
 
+// GetHostApiPrefix returns the api prefix of a host, if the prefix is empty the default one is used
+func GetHostApiPrefix(prefix string) string {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	if prefix == "" {
+		return constants.API_PREFIX
+	}
+
+	return "/" + prefix
+}
+
+// GetHostApiBaseUrlWithPrefixAndVersion returns the api url for a host using a custom api prefix and api version
+func GetHostApiBaseUrlWithPrefixAndVersion(host string, prefix string, version string) string {
+	version = strings.Trim(version, "/")
+	if version == "" {
+		return strings.TrimSuffix(GetHostUrl(host)+GetHostApiPrefix(prefix), "/")
+	}
+
+	return strings.TrimSuffix(GetHostUrl(host)+GetHostApiPrefix(prefix)+"/"+version, "/")
+}
+
+// SplitHostApiPrefix splits a host url like https://gateway.example.com/devops/api into the host
+// and the api prefix, the prefix is empty if the url does not contain a path
+func SplitHostApiPrefix(host string) (string, string) {
+	scheme := ""
+	if schemeIndex := strings.Index(host, "://"); schemeIndex != -1 {
+		scheme = host[:schemeIndex+3]
+		host = host[schemeIndex+3:]
+	}
+
+	host = strings.TrimSuffix(host, "/")
+	pathIndex := strings.Index(host, "/")
+	if pathIndex == -1 {
+		return scheme + host, ""
+	}
+
+	return scheme + host[:pathIndex], host[pathIndex:]
+}
+
 func ConvertByteToGigabyte(bytes float64) float64 {
 	gb := bytes / 1024 / 1024 / 1024
 	return math.Round(gb*100) / 100
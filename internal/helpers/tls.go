@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LoadCaCertificate returns a certificate pool with the system certificates and the given certificate
+// authority, the certificate can be a path to a PEM file, a PEM string or a base64 encoded PEM string
+func LoadCaCertificate(caCertificate string) (*x509.CertPool, error) {
+	caCertificate = strings.TrimSpace(caCertificate)
+	if caCertificate == "" {
+		return nil, errors.New("the ca certificate cannot be empty")
+	}
+
+	var pemData []byte
+	if strings.HasPrefix(caCertificate, "-----BEGIN") {
+		pemData = []byte(caCertificate)
+	} else if _, err := os.Stat(caCertificate); err == nil {
+		content, err := os.ReadFile(filepath.Clean(caCertificate))
+		if err != nil {
+			return nil, fmt.Errorf("could not read the ca certificate file %s: %v", caCertificate, err)
+		}
+		pemData = content
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(caCertificate)
+		if err != nil {
+			return nil, errors.New("the ca certificate is not a file, a PEM certificate or a base64 encoded PEM certificate")
+		}
+		pemData = decoded
+	}
+
+	certPool, err := x509.SystemCertPool()
+	if err != nil || certPool == nil {
+		certPool = x509.NewCertPool()
+	}
+	if !certPool.AppendCertsFromPEM(pemData) {
+		return nil, errors.New("the ca certificate does not contain any valid PEM certificate")
+	}
+
+	return certPool, nil
+}
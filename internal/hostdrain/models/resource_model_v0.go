@@ -0,0 +1,33 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HostDrainResourceModelV0 describes the resource data model.
+type HostDrainResourceModelV0 struct {
+	Authenticator     *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel         *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Timeouts          timeouts.Value                `tfsdk:"timeouts"`
+	Orchestrator      types.String                  `tfsdk:"orchestrator"`
+	HostId            types.String                  `tfsdk:"host_id"`
+	ID                types.String                  `tfsdk:"id"`
+	VmAction          types.String                  `tfsdk:"vm_action"`
+	CatalogConnection types.String                  `tfsdk:"catalog_connection"`
+	KeepCatalogImages types.Bool                    `tfsdk:"keep_catalog_images"`
+	WaitUntilEmpty    types.Bool                    `tfsdk:"wait_until_empty"`
+	MovedVms          []MovedVmModelV0              `tfsdk:"moved_vms"`
+}
+
+// MovedVmModelV0 describes a machine that was stopped or migrated when draining the host.
+type MovedVmModelV0 struct {
+	VmId      types.String `tfsdk:"vm_id"`
+	Name      types.String `tfsdk:"name"`
+	Action    types.String `tfsdk:"action"`
+	NewVmId   types.String `tfsdk:"new_vm_id"`
+	NewHostId types.String `tfsdk:"new_host_id"`
+}
@@ -0,0 +1,520 @@
+package hostdrain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/constants"
+	resource_models "terraform-provider-parallels-desktop/internal/hostdrain/models"
+	"terraform-provider-parallels-desktop/internal/hostdrain/schemas"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/schemas/orchestrator"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &HostDrainResource{}
+	_ resource.ResourceWithModifyPlan = &HostDrainResource{}
+)
+
+var invalidCatalogIdCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func NewHostDrainResource() resource.Resource {
+	return &HostDrainResource{}
+}
+
+// HostDrainResource defines the resource implementation.
+type HostDrainResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *HostDrainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_drain"
+}
+
+func (r *HostDrainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetHostDrainSchemaV0(ctx)
+}
+
+func (r *HostDrainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *HostDrainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.HostDrainResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventHostDrain, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout, migrating machines can take a while
+	createTimeout, diags := data.Timeouts.Create(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if data.VmAction.ValueString() == constants.HostDrainVmActionMigrate && data.CatalogConnection.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("catalog_connection"), "catalog_connection cannot be empty", "The catalog connection is required to migrate the machines")
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	hostId := data.HostId.ValueString()
+
+	host, hostDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, hostId)
+	if hostDiag.HasError() {
+		resp.Diagnostics.Append(hostDiag...)
+		return
+	}
+	if host == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("host_id"), "Host not found", "Could not find the host "+hostId+" in the orchestrator")
+		return
+	}
+
+	if !host.Maintenance {
+		tflog.Info(ctx, "Putting host "+hostId+" in maintenance")
+		if diag := apiclient.SetOrchestratorHostMaintenance(ctx, hostConfig, hostId, true); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	// the host is out of service from this point, saving it so a failure below taints the
+	// resource and the host is put back in service when it is destroyed
+	data.ID = types.StringValue(hostId)
+	data.MovedVms = make([]resource_models.MovedVmModelV0, 0)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vms, vmsDiag := getHostVms(ctx, hostConfig, hostId)
+	if vmsDiag.HasError() {
+		resp.Diagnostics.Append(vmsDiag...)
+		return
+	}
+
+	for _, vm := range vms {
+		switch data.VmAction.ValueString() {
+		case constants.HostDrainVmActionStop:
+			if vm.State == "stopped" {
+				continue
+			}
+
+			tflog.Info(ctx, "Stopping machine "+vm.Name+" in host "+hostId)
+			if _, stopDiag := common.EnsureMachineStopped(ctx, hostConfig, &vm); stopDiag.HasError() {
+				resp.Diagnostics.Append(stopDiag...)
+			} else {
+				data.MovedVms = append(data.MovedVms, resource_models.MovedVmModelV0{
+					VmId:      types.StringValue(vm.ID),
+					Name:      types.StringValue(vm.Name),
+					Action:    types.StringValue("stopped"),
+					NewVmId:   types.StringNull(),
+					NewHostId: types.StringNull(),
+				})
+			}
+		case constants.HostDrainVmActionMigrate:
+			tflog.Info(ctx, "Migrating machine "+vm.Name+" from host "+hostId)
+			movedVm, migrateDiag := r.migrateVm(ctx, hostConfig, data, vm)
+			resp.Diagnostics.Append(migrateDiag...)
+			if movedVm != nil {
+				data.MovedVms = append(data.MovedVms, *movedVm)
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if migrated := getMigratedVms(data.MovedVms); len(migrated) > 0 {
+		resp.Diagnostics.AddWarning("Migrated machines have new ids", "The machines "+strings.Join(migrated, ", ")+" were created again in other hosts with new ids, "+
+			"resources managed by other Terraform states still use the old ids and need to be imported again with the new ids listed in moved_vms")
+	}
+
+	if data.WaitUntilEmpty.ValueBool() {
+		if waitDiag := waitUntilEmpty(ctx, hostConfig, hostId); waitDiag.HasError() {
+			resp.Diagnostics.Append(waitDiag...)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HostDrainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.HostDrainResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventHostDrain, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, hostDiag := apiclient.GetOrchestratorHost(ctx, r.getHostConfig(data), data.HostId.ValueString())
+	if hostDiag.HasError() {
+		resp.Diagnostics.Append(hostDiag...)
+		return
+	}
+
+	// the host was removed or put back in service outside terraform, it needs to be drained again
+	if host == nil || !host.Maintenance {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HostDrainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.HostDrainResourceModelV0
+	var currentData resource_models.HostDrainResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventHostDrain, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the only attributes that can change without replacing the resource are the credentials and
+	// the ones used when migrating, the host stays drained
+	data.ID = currentData.ID
+	data.MovedVms = currentData.MovedVms
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HostDrainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.HostDrainResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventHostDrain, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	host, hostDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, data.HostId.ValueString())
+	if hostDiag.HasError() {
+		resp.Diagnostics.Append(hostDiag...)
+		return
+	}
+
+	// nothing to put back in service if the host is gone
+	if host == nil || !host.Maintenance {
+		return
+	}
+
+	tflog.Info(ctx, "Putting host "+data.HostId.ValueString()+" back in service")
+	if diag := apiclient.SetOrchestratorHostMaintenance(ctx, hostConfig, data.HostId.ValueString(), false); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+}
+
+// ModifyPlan warns that migrating the machines gives them new ids, the machines can be managed by
+// other Terraform states that only know the old ids
+func (r *HostDrainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data resource_models.HostDrainResourceModelV0
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an orchestrator_host resource always sets the maintenance mode of its host, it would put the drained host back in service
+	if owner := orchestrator.ClaimHostMaintenance(data.Orchestrator.ValueString(), data.HostId.ValueString(), "parallels-desktop_host_drain"); owner != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("host_id"), "Host maintenance already managed",
+			"The maintenance mode of the host "+data.HostId.ValueString()+" is managed by a "+owner+" resource, the host cannot be drained while that resource manages it")
+		return
+	}
+
+	// the machines are only migrated when the resource is created
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	if data.VmAction.ValueString() == constants.HostDrainVmActionMigrate {
+		resp.Diagnostics.AddAttributeWarning(path.Root("vm_action"), "Migrated machines get new ids",
+			"The machines in the host are created again in other hosts with new ids, resources managing them in other Terraform states will need to be imported again with the new ids listed in moved_vms")
+	}
+}
+
+func (r *HostDrainResource) getHostConfig(data resource_models.HostDrainResourceModelV0) apiclient.HostConfig {
+	return apiclient.HostConfig{
+		Host:                 data.Orchestrator.ValueString(),
+		IsOrchestrator:       true,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+}
+
+// migrateVm pushes the machine to the catalog from the drained host and creates it again through
+// the orchestrator, as the drained host is in maintenance the orchestrator places it in another host.
+// The original machine is only removed once the new one exists
+func (r *HostDrainResource) migrateVm(ctx context.Context, hostConfig apiclient.HostConfig, data resource_models.HostDrainResourceModelV0, vm apimodels.VirtualMachine) (*resource_models.MovedVmModelV0, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	hostId := data.HostId.ValueString()
+
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		diagnostics.AddError("error parsing host connection string", err.Error())
+		return nil, diagnostics
+	}
+
+	wasRunning := vm.State != "stopped"
+	stoppedVm, stopDiag := common.EnsureMachineStopped(ctx, hostConfig, &vm)
+	if stopDiag.HasError() {
+		diagnostics.Append(stopDiag...)
+		return nil, diagnostics
+	}
+
+	// starting the machine again if it cannot be migrated, so it keeps running in the drained host
+	restore := func() {
+		if wasRunning {
+			if _, startDiag := common.EnsureMachineRunning(ctx, hostConfig, stoppedVm); startDiag.HasError() {
+				diagnostics.Append(startDiag...)
+			}
+		}
+	}
+
+	// the machine id keeps the catalog image unique when machines have names that only differ in
+	// the characters not allowed in the catalog id
+	catalogId := invalidCatalogIdCharacters.ReplaceAllString(strings.ToLower(vm.Name), "-")
+	version := "drain-" + time.Now().UTC().Format("20060102150405") + "-" + invalidCatalogIdCharacters.ReplaceAllString(strings.ToLower(vm.ID), "-")
+
+	pushHostConfig := hostConfig
+	pushHostConfig.HostId = hostId
+	manifest, pushDiag := apiclient.PushCatalog(ctx, pushHostConfig, apimodels.PushCatalogRequest{
+		CatalogId:   catalogId,
+		Version:     version,
+		Connection:  catalogConnection,
+		LocalPath:   stoppedVm.Home,
+		Description: "Migrated from host " + hostId,
+	})
+	if pushDiag.HasError() {
+		diagnostics.Append(pushDiag...)
+		restore()
+		return nil, diagnostics
+	}
+
+	createResponse, createDiag := apiclient.CreateVm(ctx, hostConfig, apimodels.CreateVmRequest{
+		Name:         vm.Name,
+		Owner:        vm.User,
+		Architecture: manifest.Architecture,
+		CatalogManifest: &apimodels.CreateCatalogManifestRequest{
+			MachineName:    vm.Name,
+			CatalogId:      catalogId,
+			Version:        version,
+			Architecture:   manifest.Architecture,
+			Connection:     catalogConnection,
+			StartAfterPull: wasRunning,
+		},
+	})
+	if createDiag.HasError() {
+		diagnostics.Append(createDiag...)
+		restore()
+		return nil, diagnostics
+	}
+	if createResponse == nil || createResponse.ID == "" {
+		diagnostics.AddError("Error migrating machine", "The orchestrator did not return the id of the machine "+vm.Name+" created again")
+		restore()
+		return nil, diagnostics
+	}
+
+	// removing the new machine and starting the original one again, so only one of them is kept
+	removeNewVm := func() {
+		if removeDiag := common.EnsureMachineIsRemoved(ctx, hostConfig, createResponse.ID); removeDiag.HasError() {
+			diagnostics.Append(removeDiag...)
+		}
+		restore()
+	}
+
+	newVm, newVmDiag := apiclient.GetVm(ctx, hostConfig, createResponse.ID)
+	if newVmDiag.HasError() {
+		diagnostics.Append(newVmDiag...)
+		removeNewVm()
+		return nil, diagnostics
+	}
+	if newVm == nil {
+		diagnostics.AddError("Error migrating machine", "Could not find the machine "+vm.Name+" after creating it again")
+		removeNewVm()
+		return nil, diagnostics
+	}
+	if newVm.HostId == hostId {
+		diagnostics.AddError("Error migrating machine", "The orchestrator created the machine "+vm.Name+" in the host being drained, please check the host is in maintenance")
+		removeNewVm()
+		return nil, diagnostics
+	}
+
+	movedVm := &resource_models.MovedVmModelV0{
+		VmId:      types.StringValue(vm.ID),
+		Name:      types.StringValue(vm.Name),
+		Action:    types.StringValue("migrated"),
+		NewVmId:   types.StringValue(newVm.ID),
+		NewHostId: types.StringValue(newVm.HostId),
+	}
+
+	if removeDiag := common.EnsureMachineIsRemoved(ctx, hostConfig, vm.ID); removeDiag.HasError() {
+		diagnostics.Append(removeDiag...)
+		return movedVm, diagnostics
+	}
+
+	if !data.KeepCatalogImages.ValueBool() {
+		catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeWarning(path.Root("catalog_connection"), "error parsing host connection string", err.Error())
+			return movedVm, diagnostics
+		}
+		catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || r.provider.DisableTlsValidation.ValueBool()
+
+		if deleteDiag := apiclient.DeleteCatalogManifest(ctx, *catalogHostConfig, catalogId, version, manifest.Architecture); deleteDiag.HasError() {
+			diagnostics.AddWarning("Error deleting catalog image", "The machine "+vm.Name+" was migrated but the catalog image "+catalogId+" version "+version+" could not be deleted")
+		}
+	}
+
+	return movedVm, diagnostics
+}
+
+// getHostVms returns the machines the orchestrator reports in the host
+func getHostVms(ctx context.Context, hostConfig apiclient.HostConfig, hostId string) ([]apimodels.VirtualMachine, diag.Diagnostics) {
+	vms, diag := apiclient.GetVms(ctx, hostConfig, "", "")
+	if diag.HasError() {
+		return nil, diag
+	}
+
+	result := make([]apimodels.VirtualMachine, 0)
+	for _, vm := range vms {
+		if vm.HostId == hostId {
+			result = append(result, vm)
+		}
+	}
+
+	return result, diag
+}
+
+// waitUntilEmpty waits until there are no running machines in the host or the context times out
+func waitUntilEmpty(ctx context.Context, hostConfig apiclient.HostConfig, hostId string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	for {
+		vms, vmsDiag := getHostVms(ctx, hostConfig, hostId)
+		if vmsDiag.HasError() {
+			diagnostics.Append(vmsDiag...)
+			return diagnostics
+		}
+
+		running := make([]string, 0)
+		for _, vm := range vms {
+			if vm.State != "stopped" {
+				running = append(running, vm.Name)
+			}
+		}
+		if len(running) == 0 {
+			return diagnostics
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Waiting for %d machines to leave host %s", len(running), hostId))
+		select {
+		case <-ctx.Done():
+			diagnostics.AddError("Timeout draining host", "The host "+hostId+" still has running machines: "+strings.Join(running, ", "))
+			return diagnostics
+		case <-time.After(constants.DEFAULT_OPERATION_RETRY_INTERVAL_IN_SECONDS * time.Second):
+		}
+	}
+}
+
+// getMigratedVms returns the names of the machines created again in other hosts
+func getMigratedVms(movedVms []resource_models.MovedVmModelV0) []string {
+	result := make([]string, 0)
+	for _, movedVm := range movedVms {
+		if movedVm.Action.ValueString() == "migrated" {
+			result = append(result, movedVm.Name.ValueString()+" ("+movedVm.VmId.ValueString()+" is now "+movedVm.NewVmId.ValueString()+")")
+		}
+	}
+
+	return result
+}
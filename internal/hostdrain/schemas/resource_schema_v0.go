@@ -0,0 +1,124 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/constants"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func GetHostDrainSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Desktop DevOps Host Drain Resource\n Use this to take an orchestrator host out of service, while the resource exists the host is in maintenance mode and the orchestrator will not place new machines on it. " +
+			"The machines running in the host can be stopped or migrated to other hosts through a catalog, destroying the resource puts the host back in service but does not move the machines back. " +
+			"A host managed by a `parallels-desktop_orchestrator_host` resource cannot be drained, that resource always sets the maintenance mode of the host.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+		},
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_id": schema.StringAttribute{
+				MarkdownDescription: "Orchestrator host id to drain",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Host drain id, the same as the host id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_action": schema.StringAttribute{
+				MarkdownDescription: "What to do with the machines in the host, `none` leaves them running, `stop` stops them and `migrate` pushes them to the catalog and creates them again in other hosts. Migrated machines get new ids, resources managing them in other Terraform states need to be imported again. Defaults to `none`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(constants.HostDrainVmActionNone),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(constants.HostDrainVmActionNone, constants.HostDrainVmActionStop, constants.HostDrainVmActionMigrate),
+				},
+			},
+			"catalog_connection": schema.StringAttribute{
+				MarkdownDescription: "Parallels DevOps Catalog Connection used to migrate the machines, required when `vm_action` is `migrate`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"keep_catalog_images": schema.BoolAttribute{
+				MarkdownDescription: "Keeps the catalog images created to migrate the machines, by default they are deleted once the machine is running in the new host",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_until_empty": schema.BoolAttribute{
+				MarkdownDescription: "Waits until there are no running machines in the host, when `vm_action` is `none` this waits for the machines to be stopped or moved by other means. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"moved_vms": schema.ListNestedAttribute{
+				MarkdownDescription: "The machines that were stopped or migrated when draining the host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vm_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the machine in the drained host",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the machine",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "What was done with the machine, `stopped` or `migrated`",
+							Computed:            true,
+						},
+						"new_vm_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the migrated machine",
+							Computed:            true,
+						},
+						"new_host_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the host the machine was migrated to",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
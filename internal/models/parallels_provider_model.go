@@ -7,4 +7,7 @@ type ParallelsProviderModel struct {
 	MyAccountUser        types.String `tfsdk:"my_account_user"`
 	MyAccountPassword    types.String `tfsdk:"my_account_password"`
 	DisableTlsValidation types.Bool   `tfsdk:"disable_tls_validation"`
+	ApiPrefix            types.String `tfsdk:"api_prefix"`
+	DefaultHost          types.String `tfsdk:"default_host"`
+	DefaultOrchestrator  types.String `tfsdk:"default_orchestrator"`
 }
@@ -0,0 +1,95 @@
+package orchestratorhost
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	data_models "terraform-provider-parallels-desktop/internal/orchestratorhost/models"
+	"terraform-provider-parallels-desktop/internal/orchestratorhost/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &OrchestratorHostsDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrchestratorHostsDataSource{}
+)
+
+func NewOrchestratorHostsDataSource() datasource.DataSource {
+	return &OrchestratorHostsDataSource{}
+}
+
+type OrchestratorHostsDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *OrchestratorHostsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *OrchestratorHostsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_hosts"
+}
+
+func (d *OrchestratorHostsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.OrchestratorHostsDataSourceSchemaV0
+}
+
+func (d *OrchestratorHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.OrchestratorHostsDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Orchestrator.ValueString() == "" {
+		resp.Diagnostics.AddError("orchestrator cannot be empty", "Orchestrator cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Orchestrator.ValueString(),
+		IsOrchestrator:       true,
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	hosts, diag := apiclient.GetOrchestratorHosts(ctx, hostConfig)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	hosts = common.FilterOrchestratorHosts(hosts, data.Architecture.ValueString(), data.State.ValueString(), common.GetStrings(data.Tags))
+
+	data.Hosts = make([]data_models.OrchestratorHostModelV0, 0)
+	for _, host := range hosts {
+		data.Hosts = append(data.Hosts, data_models.NewOrchestratorHostModelV0(host))
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
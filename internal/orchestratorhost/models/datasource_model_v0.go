@@ -0,0 +1,197 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OrchestratorHostsDataSourceModelV0 represents the data source schema for the orchestrator_hosts data source.
+type OrchestratorHostsDataSourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Orchestrator  types.String                  `tfsdk:"orchestrator"`
+	Architecture  types.String                  `tfsdk:"architecture"`
+	State         types.String                  `tfsdk:"state"`
+	Tags          []types.String                `tfsdk:"tags"`
+	Hosts         []OrchestratorHostModelV0     `tfsdk:"hosts"`
+}
+
+// OrchestratorHostModelV0 represents a host registered in the orchestrator.
+type OrchestratorHostModelV0 struct {
+	ID                       types.String                      `tfsdk:"id"`                         // The unique identifier of the host.
+	Host                     types.String                      `tfsdk:"host"`                       // The address of the host.
+	Description              types.String                      `tfsdk:"description"`                // The description of the host.
+	Enabled                  types.Bool                        `tfsdk:"enabled"`                    // Whether the host is enabled.
+	State                    types.String                      `tfsdk:"state"`                      // The state of the host.
+	Architecture             types.String                      `tfsdk:"architecture"`               // The architecture of the host.
+	CpuModel                 types.String                      `tfsdk:"cpu_model"`                  // The cpu model of the host.
+	OsName                   types.String                      `tfsdk:"os_name"`                    // The operating system name.
+	OsVersion                types.String                      `tfsdk:"os_version"`                 // The operating system version.
+	ExternalIpAddress        types.String                      `tfsdk:"external_ip_address"`        // The external ip address of the host.
+	DevOpsVersion            types.String                      `tfsdk:"devops_version"`             // The Parallels DevOps version.
+	ParallelsDesktopVersion  types.String                      `tfsdk:"parallels_desktop_version"`  // The Parallels Desktop version.
+	ParallelsDesktopLicensed types.Bool                        `tfsdk:"parallels_desktop_licensed"` // Whether Parallels Desktop is licensed.
+	IsReverseProxyEnabled    types.Bool                        `tfsdk:"is_reverse_proxy_enabled"`   // Whether the reverse proxy is enabled.
+	Tags                     []types.String                    `tfsdk:"tags"`                       // The tags of the host.
+	RequiredClaims           []types.String                    `tfsdk:"required_claims"`            // The claims required to use the host.
+	RequiredRoles            []types.String                    `tfsdk:"required_roles"`             // The roles required to use the host.
+	Resources                *OrchestratorHostResourcesModelV0 `tfsdk:"resources"`                  // The hardware resources of the host.
+}
+
+// OrchestratorHostResourcesModelV0 represents the hardware resources of an orchestrator host.
+type OrchestratorHostResourcesModelV0 struct {
+	TotalAppleVms    types.Int64   `tfsdk:"total_apple_vms"`
+	PhysicalCpuCount types.Int64   `tfsdk:"physical_cpu_count"`
+	LogicalCpuCount  types.Int64   `tfsdk:"logical_cpu_count"`
+	MemorySize       types.Float64 `tfsdk:"memory_size"`
+	DiskSize         types.Float64 `tfsdk:"disk_size"`
+	FreeDiskSize     types.Float64 `tfsdk:"free_disk_size"`
+}
+
+// OrchestratorResourcesDataSourceModelV0 represents the data source schema for the orchestrator_resources data source.
+type OrchestratorResourcesDataSourceModelV0 struct {
+	Authenticator  *authenticator.Authentication          `tfsdk:"authenticator"`
+	SshTunnel      *sshtunnel.SshTunnel                   `tfsdk:"ssh_tunnel"`
+	Orchestrator   types.String                           `tfsdk:"orchestrator"`
+	Architecture   types.String                           `tfsdk:"architecture"`
+	HostIds        []types.String                         `tfsdk:"host_ids"`
+	Total          *UsageModelV0                          `tfsdk:"total"`
+	TotalAvailable *UsageModelV0                          `tfsdk:"total_available"`
+	TotalInUse     *UsageModelV0                          `tfsdk:"total_in_use"`
+	TotalReserved  *UsageModelV0                          `tfsdk:"total_reserved"`
+	SystemReserved *UsageModelV0                          `tfsdk:"system_reserved"`
+	Architectures  []OrchestratorArchitectureUsageModelV0 `tfsdk:"architectures"`
+	Hosts          []OrchestratorHostUsageModelV0         `tfsdk:"hosts"`
+}
+
+// OrchestratorArchitectureUsageModelV0 represents the resources of all the hosts with the same architecture.
+type OrchestratorArchitectureUsageModelV0 struct {
+	Architecture   types.String  `tfsdk:"architecture"`
+	CpuBrand       types.String  `tfsdk:"cpu_brand"`
+	Total          *UsageModelV0 `tfsdk:"total"`
+	TotalAvailable *UsageModelV0 `tfsdk:"total_available"`
+	TotalInUse     *UsageModelV0 `tfsdk:"total_in_use"`
+	TotalReserved  *UsageModelV0 `tfsdk:"total_reserved"`
+	SystemReserved *UsageModelV0 `tfsdk:"system_reserved"`
+}
+
+// OrchestratorHostUsageModelV0 represents the resources of a single orchestrator host.
+type OrchestratorHostUsageModelV0 struct {
+	HostId         types.String  `tfsdk:"host_id"`
+	Host           types.String  `tfsdk:"host"`
+	Architecture   types.String  `tfsdk:"architecture"`
+	State          types.String  `tfsdk:"state"`
+	Enabled        types.Bool    `tfsdk:"enabled"`
+	Total          *UsageModelV0 `tfsdk:"total"`
+	TotalAvailable *UsageModelV0 `tfsdk:"total_available"`
+	TotalInUse     *UsageModelV0 `tfsdk:"total_in_use"`
+	TotalReserved  *UsageModelV0 `tfsdk:"total_reserved"`
+	SystemReserved *UsageModelV0 `tfsdk:"system_reserved"`
+}
+
+// UsageModelV0 represents an amount of cpu, memory and disk.
+type UsageModelV0 struct {
+	PhysicalCpuCount types.Int64   `tfsdk:"physical_cpu_count"`
+	LogicalCpuCount  types.Int64   `tfsdk:"logical_cpu_count"`
+	MemorySize       types.Float64 `tfsdk:"memory_size"`
+	DiskSize         types.Float64 `tfsdk:"disk_size"`
+}
+
+// NewOrchestratorHostModelV0 maps an orchestrator host into the data source model
+func NewOrchestratorHostModelV0(host apimodels.OrchestratorHost) OrchestratorHostModelV0 {
+	return OrchestratorHostModelV0{
+		ID:                       types.StringValue(host.ID),
+		Host:                     types.StringValue(host.Host),
+		Description:              types.StringValue(host.Description),
+		Enabled:                  types.BoolValue(host.Enabled),
+		State:                    types.StringValue(host.State),
+		Architecture:             types.StringValue(host.Architecture),
+		CpuModel:                 types.StringValue(host.CpuModel),
+		OsName:                   types.StringValue(host.OsName),
+		OsVersion:                types.StringValue(host.OsVersion),
+		ExternalIpAddress:        types.StringValue(host.ExternalIpAddress),
+		DevOpsVersion:            types.StringValue(host.DevOpsVersion),
+		ParallelsDesktopVersion:  types.StringValue(host.ParallelsDesktopVersion),
+		ParallelsDesktopLicensed: types.BoolValue(host.ParallelsDesktopLicensed),
+		IsReverseProxyEnabled:    types.BoolValue(host.IsReverseProxyEnabled),
+		Tags:                     toStringValues(host.Tags),
+		RequiredClaims:           toStringValues(host.RequiredClaims),
+		RequiredRoles:            toStringValues(host.RequiredRoles),
+		Resources: &OrchestratorHostResourcesModelV0{
+			TotalAppleVms:    types.Int64Value(host.Resources.TotalAppleVms),
+			PhysicalCpuCount: types.Int64Value(host.Resources.PhysicalCpuCount),
+			LogicalCpuCount:  types.Int64Value(host.Resources.LogicalCpuCount),
+			MemorySize:       types.Float64Value(host.Resources.MemorySize),
+			DiskSize:         types.Float64Value(host.Resources.DiskSize),
+			FreeDiskSize:     types.Float64Value(host.Resources.FreeDiskSize),
+		},
+	}
+}
+
+// NewOrchestratorArchitectureUsageModelV0 maps the resources of an architecture into the data source model
+func NewOrchestratorArchitectureUsageModelV0(usage *apimodels.SystemUsageResponse) OrchestratorArchitectureUsageModelV0 {
+	return OrchestratorArchitectureUsageModelV0{
+		Architecture:   types.StringValue(usage.CpuType),
+		CpuBrand:       types.StringValue(usage.CpuBrand),
+		Total:          NewUsageModelV0(usage.Total),
+		TotalAvailable: NewUsageModelV0(usage.TotalAvailable),
+		TotalInUse:     NewUsageModelV0(usage.TotalInUse),
+		TotalReserved:  NewUsageModelV0(usage.TotalReserved),
+		SystemReserved: NewUsageModelV0(usage.SystemReserved),
+	}
+}
+
+// NewOrchestratorHostUsageModelV0 maps the resources of a host into the data source model
+func NewOrchestratorHostUsageModelV0(host apimodels.OrchestratorHost, usage *apimodels.SystemUsageResponse) OrchestratorHostUsageModelV0 {
+	return OrchestratorHostUsageModelV0{
+		HostId:         types.StringValue(host.ID),
+		Host:           types.StringValue(host.Host),
+		Architecture:   types.StringValue(host.Architecture),
+		State:          types.StringValue(host.State),
+		Enabled:        types.BoolValue(host.Enabled),
+		Total:          NewUsageModelV0(usage.Total),
+		TotalAvailable: NewUsageModelV0(usage.TotalAvailable),
+		TotalInUse:     NewUsageModelV0(usage.TotalInUse),
+		TotalReserved:  NewUsageModelV0(usage.TotalReserved),
+		SystemReserved: NewUsageModelV0(usage.SystemReserved),
+	}
+}
+
+// NewUsageModelV0 maps a system usage item into the data source model, a missing item
+// is reported as zero
+func NewUsageModelV0(item *apimodels.SystemUsageItem) *UsageModelV0 {
+	if item == nil {
+		item = &apimodels.SystemUsageItem{}
+	}
+
+	return &UsageModelV0{
+		PhysicalCpuCount: types.Int64Value(item.PhysicalCpuCount),
+		LogicalCpuCount:  types.Int64Value(item.LogicalCpuCount),
+		MemorySize:       types.Float64Value(item.MemorySize),
+		DiskSize:         types.Float64Value(item.DiskSize),
+	}
+}
+
+// Add sums the values of another usage into this one
+func (u *UsageModelV0) Add(other *UsageModelV0) {
+	if other == nil {
+		return
+	}
+
+	u.PhysicalCpuCount = types.Int64Value(u.PhysicalCpuCount.ValueInt64() + other.PhysicalCpuCount.ValueInt64())
+	u.LogicalCpuCount = types.Int64Value(u.LogicalCpuCount.ValueInt64() + other.LogicalCpuCount.ValueInt64())
+	u.MemorySize = types.Float64Value(u.MemorySize.ValueFloat64() + other.MemorySize.ValueFloat64())
+	u.DiskSize = types.Float64Value(u.DiskSize.ValueFloat64() + other.DiskSize.ValueFloat64())
+}
+
+func toStringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OrchestratorHostResourceModelV0 describes the resource data model.
+type OrchestratorHostResourceModelV0 struct {
+	Authenticator   *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel       *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	HostCredentials *authenticator.Authentication `tfsdk:"host_credentials"`
+	Orchestrator    types.String                  `tfsdk:"orchestrator"`
+	Host            types.String                  `tfsdk:"host"`
+	ID              types.String                  `tfsdk:"id"`
+	Description     types.String                  `tfsdk:"description"`
+	Tags            []types.String                `tfsdk:"tags"`
+	RequiredRoles   []types.String                `tfsdk:"required_roles"`
+	RequiredClaims  []types.String                `tfsdk:"required_claims"`
+	Enabled         types.Bool                    `tfsdk:"enabled"`
+	Maintenance     types.Bool                    `tfsdk:"maintenance"`
+	State           types.String                  `tfsdk:"state"`
+	Architecture    types.String                  `tfsdk:"architecture"`
+	CpuModel        types.String                  `tfsdk:"cpu_model"`
+	DevOpsVersion   types.String                  `tfsdk:"devops_version"`
+}
+
+// ToRequest builds the registration request sent to the orchestrator
+func (m *OrchestratorHostResourceModelV0) ToRequest() apimodels.OrchestratorHostRequest {
+	request := apimodels.OrchestratorHostRequest{
+		Host:           m.Host.ValueString(),
+		Description:    m.Description.ValueString(),
+		Tags:           common.GetStrings(m.Tags),
+		RequiredRoles:  common.GetStrings(m.RequiredRoles),
+		RequiredClaims: common.GetStrings(m.RequiredClaims),
+	}
+
+	if m.HostCredentials != nil {
+		request.Authentication = &apimodels.OrchestratorAuthentication{
+			Username: m.HostCredentials.Username.ValueString(),
+			Password: m.HostCredentials.Password.ValueString(),
+			ApiKey:   m.HostCredentials.ApiKey.ValueString(),
+		}
+	}
+
+	return request
+}
+
+// HasRegistrationChanges returns true if any of the details sent in the registration request changed
+func (m *OrchestratorHostResourceModelV0) HasRegistrationChanges(current OrchestratorHostResourceModelV0) bool {
+	if !m.Description.Equal(current.Description) {
+		return true
+	}
+	if !equalStrings(m.Tags, current.Tags) || !equalStrings(m.RequiredRoles, current.RequiredRoles) || !equalStrings(m.RequiredClaims, current.RequiredClaims) {
+		return true
+	}
+	if (m.HostCredentials == nil) != (current.HostCredentials == nil) {
+		return true
+	}
+	if m.HostCredentials != nil &&
+		(!m.HostCredentials.Username.Equal(current.HostCredentials.Username) ||
+			!m.HostCredentials.Password.Equal(current.HostCredentials.Password) ||
+			!m.HostCredentials.ApiKey.Equal(current.HostCredentials.ApiKey)) {
+		return true
+	}
+
+	return false
+}
+
+// FromApiModel updates the model with the host registered in the orchestrator, the host address and
+// credentials are kept as the orchestrator might normalize the address and never returns the credentials
+func (m *OrchestratorHostResourceModelV0) FromApiModel(host *apimodels.OrchestratorHost) {
+	m.ID = types.StringValue(host.ID)
+	if common.GetString(m.Host) == "" {
+		m.Host = types.StringValue(host.Host)
+	}
+	if host.Description == "" && m.Description.IsNull() {
+		m.Description = types.StringNull()
+	} else {
+		m.Description = types.StringValue(host.Description)
+	}
+	m.Tags = readStrings(host.Tags, m.Tags)
+	m.RequiredRoles = readStrings(host.RequiredRoles, m.RequiredRoles)
+	m.RequiredClaims = readStrings(host.RequiredClaims, m.RequiredClaims)
+	m.Enabled = types.BoolValue(host.Enabled)
+	m.Maintenance = types.BoolValue(host.Maintenance)
+	m.State = types.StringValue(host.State)
+	m.Architecture = types.StringValue(host.Architecture)
+	m.CpuModel = types.StringValue(host.CpuModel)
+	m.DevOpsVersion = types.StringValue(host.DevOpsVersion)
+}
+
+// readStrings keeps an empty list if it was set that way, the api omits empty lists
+func readStrings(values []string, prior []types.String) []types.String {
+	if len(values) == 0 {
+		if prior != nil && len(prior) == 0 {
+			return prior
+		}
+		return nil
+	}
+
+	return toStringValues(values)
+}
+
+func equalStrings(a []types.String, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,329 @@
+package orchestratorhost
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	resource_models "terraform-provider-parallels-desktop/internal/orchestratorhost/models"
+	"terraform-provider-parallels-desktop/internal/orchestratorhost/schemas"
+	"terraform-provider-parallels-desktop/internal/schemas/orchestrator"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &OrchestratorHostResource{}
+	_ resource.ResourceWithImportState = &OrchestratorHostResource{}
+	_ resource.ResourceWithModifyPlan  = &OrchestratorHostResource{}
+)
+
+func NewOrchestratorHostResource() resource.Resource {
+	return &OrchestratorHostResource{}
+}
+
+// OrchestratorHostResource defines the resource implementation.
+type OrchestratorHostResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *OrchestratorHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_host"
+}
+
+func (r *OrchestratorHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetOrchestratorHostSchemaV0(ctx)
+}
+
+func (r *OrchestratorHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *OrchestratorHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.OrchestratorHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventOrchestratorHost, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+
+	tflog.Info(ctx, "Registering host "+data.Host.ValueString()+" in the orchestrator")
+	response, registerDiag := apiclient.RegisterWithOrchestrator(ctx, hostConfig, data.ToRequest())
+	if registerDiag.HasError() {
+		resp.Diagnostics.Append(registerDiag...)
+		return
+	}
+	if response == nil || response.ID == "" {
+		resp.Diagnostics.AddError("Error registering host", "The orchestrator did not return the id of the registered host")
+		return
+	}
+
+	// the host is registered from this point, saving it so a failure below taints the
+	// resource instead of leaving a registration terraform does not know about
+	data.ID = types.StringValue(response.ID)
+	plannedEnabled := data.Enabled.ValueBool()
+	plannedMaintenance := data.Maintenance.ValueBool()
+	if diag := r.refresh(ctx, hostConfig, &data); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if diag := r.setHostState(ctx, hostConfig, &data, plannedEnabled, plannedMaintenance); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrchestratorHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.OrchestratorHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventOrchestratorHost, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	host, hostDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, data.ID.ValueString())
+	if hostDiag.HasError() {
+		resp.Diagnostics.Append(hostDiag...)
+		return
+	}
+
+	// the host was unregistered outside terraform, it needs to be registered again
+	if host == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.FromApiModel(host)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrchestratorHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.OrchestratorHostResourceModelV0
+	var currentData resource_models.OrchestratorHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventOrchestratorHost, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	data.ID = currentData.ID
+
+	if data.HasRegistrationChanges(currentData) {
+		tflog.Info(ctx, "Updating orchestrator host "+data.ID.ValueString())
+		if diag := apiclient.UpdateOrchestratorHost(ctx, hostConfig, data.ID.ValueString(), data.ToRequest()); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	plannedEnabled := data.Enabled.ValueBool()
+	plannedMaintenance := data.Maintenance.ValueBool()
+	data.Enabled = currentData.Enabled
+	data.Maintenance = currentData.Maintenance
+	if diag := r.setHostState(ctx, hostConfig, &data, plannedEnabled, plannedMaintenance); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrchestratorHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.OrchestratorHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventOrchestratorHost, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Unregistering host "+data.ID.ValueString()+" from the orchestrator")
+	if diag := apiclient.UnregisterWithOrchestrator(ctx, r.getHostConfig(data), data.ID.ValueString()); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+}
+
+// ImportState sets the orchestrator, credentials and host id from the import id in the format
+// [username:password@]orchestrator@orchestrator_host_id, the Read then rebuilds the rest of the state
+func (r *OrchestratorHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orchestrator, auth, hostId, err := common.ParseImportId(req.ID, "orchestrator_host_id", "orchestrator host id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("orchestrator"), orchestrator)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hostId)...)
+	if auth != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), auth)...)
+	}
+}
+
+// ModifyPlan refuses managing a host drained by a host_drain resource, the maintenance mode is always
+// set by this resource and would put the drained host back in service
+func (r *OrchestratorHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the host id is only known once the host is registered
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data resource_models.OrchestratorHostResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if owner := orchestrator.ClaimHostMaintenance(data.Orchestrator.ValueString(), data.ID.ValueString(), "parallels-desktop_orchestrator_host"); owner != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("maintenance"), "Host maintenance already managed",
+			"The maintenance mode of the host "+data.ID.ValueString()+" is managed by a "+owner+" resource, remove that resource before managing the host")
+	}
+}
+
+func (r *OrchestratorHostResource) getHostConfig(data resource_models.OrchestratorHostResourceModelV0) apiclient.HostConfig {
+	return apiclient.HostConfig{
+		Host:                 data.Orchestrator.ValueString(),
+		IsOrchestrator:       true,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+}
+
+// setHostState enables, disables or toggles the maintenance mode of the host when it differs from
+// the current state and reads the host back so the health state is up to date
+func (r *OrchestratorHostResource) setHostState(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.OrchestratorHostResourceModelV0, enabled bool, maintenance bool) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	if data.Enabled.ValueBool() != enabled {
+		tflog.Info(ctx, fmt.Sprintf("Setting orchestrator host %s enabled to %v", data.ID.ValueString(), enabled))
+		if diag := apiclient.SetOrchestratorHostEnabled(ctx, hostConfig, data.ID.ValueString(), enabled); diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+	}
+
+	if data.Maintenance.ValueBool() != maintenance {
+		tflog.Info(ctx, fmt.Sprintf("Setting orchestrator host %s maintenance to %v", data.ID.ValueString(), maintenance))
+		if diag := apiclient.SetOrchestratorHostMaintenance(ctx, hostConfig, data.ID.ValueString(), maintenance); diag.HasError() {
+			diagnostics.Append(diag...)
+			return diagnostics
+		}
+	}
+
+	diagnostics.Append(r.refresh(ctx, hostConfig, data)...)
+	data.Enabled = types.BoolValue(enabled)
+	data.Maintenance = types.BoolValue(maintenance)
+
+	return diagnostics
+}
+
+// refresh reads the computed values of the host from the orchestrator, the configured values are
+// kept as they are so the result is always consistent with the plan
+func (r *OrchestratorHostResource) refresh(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.OrchestratorHostResourceModelV0) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	host, hostDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, data.ID.ValueString())
+	if hostDiag.HasError() {
+		diagnostics.Append(hostDiag...)
+		return diagnostics
+	}
+	if host == nil {
+		diagnostics.AddError("Host not found", "Could not find the host "+data.ID.ValueString()+" in the orchestrator")
+		return diagnostics
+	}
+
+	refreshed := *data
+	refreshed.FromApiModel(host)
+	data.Enabled = refreshed.Enabled
+	data.Maintenance = refreshed.Maintenance
+	data.State = refreshed.State
+	data.Architecture = refreshed.Architecture
+	data.CpuModel = refreshed.CpuModel
+	data.DevOpsVersion = refreshed.DevOpsVersion
+
+	return diagnostics
+}
@@ -0,0 +1,179 @@
+package orchestratorhost
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	data_models "terraform-provider-parallels-desktop/internal/orchestratorhost/models"
+	"terraform-provider-parallels-desktop/internal/orchestratorhost/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &OrchestratorResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrchestratorResourcesDataSource{}
+)
+
+func NewOrchestratorResourcesDataSource() datasource.DataSource {
+	return &OrchestratorResourcesDataSource{}
+}
+
+type OrchestratorResourcesDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *OrchestratorResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *OrchestratorResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_resources"
+}
+
+func (d *OrchestratorResourcesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.OrchestratorResourcesDataSourceSchemaV0
+}
+
+func (d *OrchestratorResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.OrchestratorResourcesDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Orchestrator.ValueString() == "" {
+		resp.Diagnostics.AddError("orchestrator cannot be empty", "Orchestrator cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 data.Orchestrator.ValueString(),
+		IsOrchestrator:       true,
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	hosts, diag := apiclient.GetOrchestratorHosts(ctx, hostConfig)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	hostIds := common.GetStrings(data.HostIds)
+	for _, hostId := range hostIds {
+		if !slices.ContainsFunc(hosts, func(host apimodels.OrchestratorHost) bool { return host.ID == hostId }) {
+			resp.Diagnostics.AddError("Host not found", "Could not find a host with ID "+hostId+" in the orchestrator")
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Hosts = make([]data_models.OrchestratorHostUsageModelV0, 0)
+	for _, host := range common.FilterOrchestratorHosts(hosts, data.Architecture.ValueString(), "", nil) {
+		if len(hostIds) > 0 && !slices.Contains(hostIds, host.ID) {
+			continue
+		}
+
+		// the hardware of each host is read through the orchestrator, hosts that do not
+		// answer are reported but do not fail the whole data source
+		hostUsageConfig := hostConfig
+		hostUsageConfig.HostId = host.ID
+		usage, diag := apiclient.GetSystemUsage(ctx, hostUsageConfig)
+		if diag.HasError() || usage == nil {
+			resp.Diagnostics.AddWarning("Error reading host resources", "Could not read the resources of the host "+host.ID+", it will not be included")
+			continue
+		}
+
+		data.Hosts = append(data.Hosts, data_models.NewOrchestratorHostUsageModelV0(host, usage))
+	}
+
+	data.Architectures = make([]data_models.OrchestratorArchitectureUsageModelV0, 0)
+	if len(hostIds) == 0 {
+		architectures, diag := apiclient.GetOrchestratorResources(ctx, hostConfig)
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+
+		for _, architecture := range architectures {
+			if architecture == nil {
+				continue
+			}
+			if data.Architecture.ValueString() != "" && !common.IsSameArchitecture(architecture.CpuType, data.Architecture.ValueString()) {
+				continue
+			}
+
+			data.Architectures = append(data.Architectures, data_models.NewOrchestratorArchitectureUsageModelV0(architecture))
+		}
+	} else {
+		// the orchestrator only reports the architectures of all its hosts, so when
+		// selecting hosts we need to add them up ourselves
+		for _, host := range data.Hosts {
+			index := slices.IndexFunc(data.Architectures, func(architecture data_models.OrchestratorArchitectureUsageModelV0) bool {
+				return common.IsSameArchitecture(architecture.Architecture.ValueString(), host.Architecture.ValueString())
+			})
+			if index < 0 {
+				data.Architectures = append(data.Architectures, data_models.OrchestratorArchitectureUsageModelV0{
+					Architecture:   host.Architecture,
+					CpuBrand:       types.StringValue(""),
+					Total:          data_models.NewUsageModelV0(nil),
+					TotalAvailable: data_models.NewUsageModelV0(nil),
+					TotalInUse:     data_models.NewUsageModelV0(nil),
+					TotalReserved:  data_models.NewUsageModelV0(nil),
+					SystemReserved: data_models.NewUsageModelV0(nil),
+				})
+				index = len(data.Architectures) - 1
+			}
+
+			data.Architectures[index].Total.Add(host.Total)
+			data.Architectures[index].TotalAvailable.Add(host.TotalAvailable)
+			data.Architectures[index].TotalInUse.Add(host.TotalInUse)
+			data.Architectures[index].TotalReserved.Add(host.TotalReserved)
+			data.Architectures[index].SystemReserved.Add(host.SystemReserved)
+		}
+	}
+
+	data.Total = data_models.NewUsageModelV0(nil)
+	data.TotalAvailable = data_models.NewUsageModelV0(nil)
+	data.TotalInUse = data_models.NewUsageModelV0(nil)
+	data.TotalReserved = data_models.NewUsageModelV0(nil)
+	data.SystemReserved = data_models.NewUsageModelV0(nil)
+	for _, architecture := range data.Architectures {
+		data.Total.Add(architecture.Total)
+		data.TotalAvailable.Add(architecture.TotalAvailable)
+		data.TotalInUse.Add(architecture.TotalInUse)
+		data.TotalReserved.Add(architecture.TotalReserved)
+		data.SystemReserved.Add(architecture.SystemReserved)
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,223 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var OrchestratorHostsDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Orchestrator Hosts Data Source, lists the hosts registered in a Parallels DevOps orchestrator",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Required:            true,
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "Only return the hosts with this architecture",
+			Optional:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Only return the hosts in this state, for example `healthy`",
+			Optional:            true,
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Only return the hosts containing all of these tags",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"hosts": schema.ListNestedAttribute{
+			MarkdownDescription: "The hosts matching the filters",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the host",
+						Computed:            true,
+					},
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The address of the host",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the host",
+						Computed:            true,
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the host is enabled in the orchestrator",
+						Computed:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "The state of the host",
+						Computed:            true,
+					},
+					"architecture": schema.StringAttribute{
+						MarkdownDescription: "The architecture of the host",
+						Computed:            true,
+					},
+					"cpu_model": schema.StringAttribute{
+						MarkdownDescription: "The cpu model of the host",
+						Computed:            true,
+					},
+					"os_name": schema.StringAttribute{
+						MarkdownDescription: "The operating system name of the host",
+						Computed:            true,
+					},
+					"os_version": schema.StringAttribute{
+						MarkdownDescription: "The operating system version of the host",
+						Computed:            true,
+					},
+					"external_ip_address": schema.StringAttribute{
+						MarkdownDescription: "The external ip address of the host",
+						Computed:            true,
+					},
+					"devops_version": schema.StringAttribute{
+						MarkdownDescription: "The Parallels DevOps version running in the host",
+						Computed:            true,
+					},
+					"parallels_desktop_version": schema.StringAttribute{
+						MarkdownDescription: "The Parallels Desktop version installed in the host",
+						Computed:            true,
+					},
+					"parallels_desktop_licensed": schema.BoolAttribute{
+						MarkdownDescription: "Whether Parallels Desktop is licensed in the host",
+						Computed:            true,
+					},
+					"is_reverse_proxy_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the reverse proxy is enabled in the host",
+						Computed:            true,
+					},
+					"tags": schema.ListAttribute{
+						MarkdownDescription: "The tags of the host",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"required_claims": schema.ListAttribute{
+						MarkdownDescription: "The claims required to use the host",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"required_roles": schema.ListAttribute{
+						MarkdownDescription: "The roles required to use the host",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"resources": schema.SingleNestedAttribute{
+						MarkdownDescription: "The hardware resources of the host",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"total_apple_vms": schema.Int64Attribute{
+								MarkdownDescription: "Number of Apple virtual machines in the host",
+								Computed:            true,
+							},
+							"physical_cpu_count": schema.Int64Attribute{
+								MarkdownDescription: "Number of physical cpus",
+								Computed:            true,
+							},
+							"logical_cpu_count": schema.Int64Attribute{
+								MarkdownDescription: "Number of logical cpus",
+								Computed:            true,
+							},
+							"memory_size": schema.Float64Attribute{
+								MarkdownDescription: "Memory size in megabytes",
+								Computed:            true,
+							},
+							"disk_size": schema.Float64Attribute{
+								MarkdownDescription: "Disk size in megabytes",
+								Computed:            true,
+							},
+							"free_disk_size": schema.Float64Attribute{
+								MarkdownDescription: "Free disk size in megabytes",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var OrchestratorResourcesDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Orchestrator Resources Data Source, reports the capacity of a Parallels DevOps orchestrator per host and per architecture",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: mergeAttributes(usageAttributesV0(), map[string]schema.Attribute{
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Required:            true,
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "Only report the resources of this architecture",
+			Optional:            true,
+		},
+		"host_ids": schema.ListAttribute{
+			MarkdownDescription: "Only report the resources of these hosts",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"architectures": schema.ListNestedAttribute{
+			MarkdownDescription: "The resources of the orchestrator grouped by architecture",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mergeAttributes(usageAttributesV0(), map[string]schema.Attribute{
+					"architecture": schema.StringAttribute{
+						MarkdownDescription: "The architecture of the hosts",
+						Computed:            true,
+					},
+					"cpu_brand": schema.StringAttribute{
+						MarkdownDescription: "The cpu brand of the hosts",
+						Computed:            true,
+					},
+				}),
+			},
+		},
+		"hosts": schema.ListNestedAttribute{
+			MarkdownDescription: "The resources of each host in the orchestrator",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mergeAttributes(usageAttributesV0(), map[string]schema.Attribute{
+					"host_id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the host",
+						Computed:            true,
+					},
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The address of the host",
+						Computed:            true,
+					},
+					"architecture": schema.StringAttribute{
+						MarkdownDescription: "The architecture of the host",
+						Computed:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "The state of the host",
+						Computed:            true,
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the host is enabled in the orchestrator",
+						Computed:            true,
+					},
+				}),
+			},
+		},
+	}),
+}
+
+func mergeAttributes(attributes ...map[string]schema.Attribute) map[string]schema.Attribute {
+	result := map[string]schema.Attribute{}
+	for _, items := range attributes {
+		for name, attribute := range items {
+			result[name] = attribute
+		}
+	}
+
+	return result
+}
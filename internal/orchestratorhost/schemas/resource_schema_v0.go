@@ -0,0 +1,109 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetOrchestratorHostSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Desktop DevOps Orchestrator Host Resource\n Use this to register an existing Parallels Desktop DevOps host in an orchestrator, only the orchestrator and the host APIs are used so the host can be provisioned by any means.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+			"host_credentials": schema.SingleNestedBlock{
+				MarkdownDescription: "Credentials the orchestrator uses to connect to the host API",
+				Attributes:          authenticator.SchemaBlock.Attributes,
+			},
+		},
+		Attributes: map[string]schema.Attribute{
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host address the orchestrator connects to, for example `https://mac-01.example.com:8080`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Orchestrator host id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the host",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the host, they can be used to select the host when creating machines",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"required_roles": schema.ListAttribute{
+				MarkdownDescription: "Roles a user needs to use the host",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"required_claims": schema.ListAttribute{
+				MarkdownDescription: "Claims a user needs to use the host",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the orchestrator uses the host, defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Puts the host in maintenance mode, the machines in the host keep running but the orchestrator will not place new machines on it. Defaults to `false`. The host cannot be drained with a `parallels-desktop_host_drain` resource while this resource manages it",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Health state of the host as reported by the orchestrator",
+				Computed:            true,
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "Architecture of the host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_model": schema.StringAttribute{
+				MarkdownDescription: "Cpu model of the host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"devops_version": schema.StringAttribute{
+				MarkdownDescription: "Parallels DevOps version running in the host",
+				Computed:            true,
+			},
+		},
+	}
+}
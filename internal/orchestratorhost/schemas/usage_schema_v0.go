@@ -0,0 +1,42 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// usageAttributeV0 describes the cpu, memory and disk of a host or of a group of hosts
+func usageAttributeV0(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"physical_cpu_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of physical cpus",
+				Computed:            true,
+			},
+			"logical_cpu_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of logical cpus",
+				Computed:            true,
+			},
+			"memory_size": schema.Float64Attribute{
+				MarkdownDescription: "Memory size in megabytes",
+				Computed:            true,
+			},
+			"disk_size": schema.Float64Attribute{
+				MarkdownDescription: "Disk size in megabytes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// usageAttributesV0 are the capacity attributes reported for a host or a group of hosts
+func usageAttributesV0() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"total":           usageAttributeV0("Total capacity"),
+		"total_available": usageAttributeV0("Capacity available to new machines"),
+		"total_in_use":    usageAttributeV0("Capacity used by the running machines"),
+		"total_reserved":  usageAttributeV0("Capacity reserved by the machines"),
+		"system_reserved": usageAttributeV0("Capacity reserved for the host system"),
+	}
+}
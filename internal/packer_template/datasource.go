@@ -67,6 +67,7 @@ func (d *PackerTemplateDataSource) Read(ctx context.Context, req datasource.Read
 		License:              d.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
 	}
 
 	templates, diag := apiclient.GetPackerTemplates(ctx, hostConfig, data.Filter.FieldName.ValueString(), data.Filter.Value.ValueString())
@@ -86,6 +86,7 @@ func (r *PackerTemplateVirtualMachineResource) Create(ctx context.Context, req r
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	vm, diag := apiclient.GetVms(ctx, hostConfig, "Name", data.Name.String())
@@ -214,6 +215,7 @@ func (r *PackerTemplateVirtualMachineResource) Read(ctx context.Context, req res
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -265,6 +267,7 @@ func (r *PackerTemplateVirtualMachineResource) Update(ctx context.Context, req r
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, currentData.ID.ValueString())
@@ -415,6 +418,7 @@ func (r *PackerTemplateVirtualMachineResource) Delete(ctx context.Context, req r
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -0,0 +1,59 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// ImportedPrivateStateKey marks a resource as imported until its first update
+const ImportedPrivateStateKey = "imported"
+
+// ImportedPrivateStateValue is the value stored in the private state for imported resources
+var ImportedPrivateStateValue = []byte("true")
+
+const requiresReplaceUnlessImportedDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource. Imported resources do not know this value, so setting it on the first apply after the import will not recreate them."
+
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// StringRequiresReplaceUnlessImported behaves like RequiresReplace but allows setting a value
+// that could not be read from the machine when the resource was imported
+func StringRequiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !(req.StateValue.IsNull() && isImported(ctx, req.Private))
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+// BoolRequiresReplaceUnlessImported behaves like RequiresReplace but allows setting a value
+// that could not be read from the machine when the resource was imported
+func BoolRequiresReplaceUnlessImported() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !(req.StateValue.IsNull() && isImported(ctx, req.Private))
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func isImported(ctx context.Context, private privateStateReader) bool {
+	if private == nil {
+		return false
+	}
+
+	value, diags := private.GetKey(ctx, ImportedPrivateStateKey)
+	if diags.HasError() {
+		return false
+	}
+
+	return string(value) == string(ImportedPrivateStateValue)
+}
@@ -3,15 +3,30 @@ package provider
 import (
 	"context"
 
+	"terraform-provider-parallels-desktop/internal/apikey"
 	"terraform-provider-parallels-desktop/internal/authorization"
+	"terraform-provider-parallels-desktop/internal/catalogcache"
+	"terraform-provider-parallels-desktop/internal/catalogimage"
+	"terraform-provider-parallels-desktop/internal/catalogpush"
+	"terraform-provider-parallels-desktop/internal/claim"
 	clonevm "terraform-provider-parallels-desktop/internal/clone_vm"
 	deploy "terraform-provider-parallels-desktop/internal/deploy"
+	"terraform-provider-parallels-desktop/internal/hostdrain"
 	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/orchestratorhost"
 	"terraform-provider-parallels-desktop/internal/remoteimage"
+	"terraform-provider-parallels-desktop/internal/reverseproxyhost"
+	"terraform-provider-parallels-desktop/internal/role"
+	"terraform-provider-parallels-desktop/internal/user"
+	"terraform-provider-parallels-desktop/internal/userroleattachment"
 	"terraform-provider-parallels-desktop/internal/vagrantbox"
 	"terraform-provider-parallels-desktop/internal/virtualmachine"
 	"terraform-provider-parallels-desktop/internal/virtualmachinestate"
+	"terraform-provider-parallels-desktop/internal/vm"
+	"terraform-provider-parallels-desktop/internal/vmsnapshot"
+	"terraform-provider-parallels-desktop/internal/vmtemplate"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -21,7 +36,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &ParallelsProvider{}
+	_ provider.Provider            = &ParallelsProvider{}
+	_ provider.ProviderWithActions = &ParallelsProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -77,6 +93,21 @@ You can also join our community on [Discord](https://discord.gg/aFsrjbkN) channe
 				MarkdownDescription: "Disable TLS validation",
 				Description:         "Disable TLS validation",
 			},
+			"api_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default API prefix of the Parallels Desktop DevOps hosts, defaults to `/api`. A host can use a different prefix by adding it to its url, for example `https://gateway.example.com/devops/api`",
+				Description:         "Default API prefix of the Parallels Desktop DevOps hosts, defaults to /api. A host can use a different prefix by adding it to its url, for example https://gateway.example.com/devops/api",
+			},
+			"default_host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default Parallels Desktop DevOps host used when a machine is imported with only its id or name",
+				Description:         "Default Parallels Desktop DevOps host used when a machine is imported with only its id or name",
+			},
+			"default_orchestrator": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default Parallels Desktop DevOps orchestrator used when a machine is imported with only its id or name and there is no `default_host`",
+				Description:         "Default Parallels Desktop DevOps orchestrator used when a machine is imported with only its id or name and there is no default_host",
+			},
 		},
 	}
 }
@@ -106,16 +137,30 @@ func (p *ParallelsProvider) Configure(ctx context.Context, req provider.Configur
 		MyAccountUser:        config.MyAccountUser,
 		MyAccountPassword:    config.MyAccountPassword,
 		DisableTlsValidation: config.DisableTlsValidation,
+		ApiPrefix:            config.ApiPrefix,
+		DefaultHost:          config.DefaultHost,
+		DefaultOrchestrator:  config.DefaultOrchestrator,
 	}
 
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
+	resp.ActionData = &data
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *ParallelsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		virtualmachine.NewVirtualMachinesDataSource,
+		vmsnapshot.NewVmSnapshotsDataSource,
+		catalogimage.NewCatalogImagesDataSource,
+		catalogimage.NewCatalogImageDataSource,
+		catalogcache.NewCatalogCacheDataSource,
+		orchestratorhost.NewOrchestratorHostsDataSource,
+		orchestratorhost.NewOrchestratorResourcesDataSource,
+		user.NewUserDataSource,
+		role.NewRoleDataSource,
+		claim.NewClaimDataSource,
+		apikey.NewApiKeyDataSource,
 		// packertemplate.NewPackerTemplateDataSource,
 	}
 }
@@ -130,5 +175,25 @@ func (p *ParallelsProvider) Resources(_ context.Context) []func() resource.Resou
 		vagrantbox.NewVagrantBoxResource,
 		remoteimage.NewRemoteVmResource,
 		clonevm.NewCloneVmResource,
+		vmsnapshot.NewVmSnapshotResource,
+		vmtemplate.NewVmTemplateResource,
+		vm.NewVmResource,
+		catalogpush.NewCatalogPushResource,
+		catalogcache.NewCatalogCacheResource,
+		reverseproxyhost.NewReverseProxyHostResource,
+		orchestratorhost.NewOrchestratorHostResource,
+		hostdrain.NewHostDrainResource,
+		user.NewUserResource,
+		role.NewRoleResource,
+		claim.NewClaimResource,
+		apikey.NewApiKeyResource,
+		userroleattachment.NewUserRoleAttachmentResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *ParallelsProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		catalogcache.NewCatalogCacheEvictAction,
 	}
 }
@@ -2,10 +2,12 @@ package models
 
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 
@@ -16,6 +18,8 @@ import (
 // VirtualMachineStateResourceModel describes the resource data model.
 type RemoteVmResourceModelV2 struct {
 	Authenticator        *authenticator.Authentication              `tfsdk:"authenticator"`
+	SshTunnel            *sshtunnel.SshTunnel                       `tfsdk:"ssh_tunnel"`
+	Placement            *placement.Placement                       `tfsdk:"placement"`
 	Host                 types.String                               `tfsdk:"host"`
 	HostUrl              types.String                               `tfsdk:"host_url"`
 	Orchestrator         types.String                               `tfsdk:"orchestrator"`
@@ -26,7 +30,11 @@ type RemoteVmResourceModelV2 struct {
 	OsType               types.String                               `tfsdk:"os_type"`
 	CatalogId            types.String                               `tfsdk:"catalog_id"`
 	Version              types.String                               `tfsdk:"version"`
+	ResolvedVersion      types.String                               `tfsdk:"resolved_version"`
+	UpgradePolicy        types.String                               `tfsdk:"upgrade_policy"`
 	Architecture         types.String                               `tfsdk:"architecture"`
+	ManifestId           types.String                               `tfsdk:"manifest_id"`
+	ManifestSize         types.Int64                                `tfsdk:"manifest_size"`
 	Name                 types.String                               `tfsdk:"name"`
 	Owner                types.String                               `tfsdk:"owner"`
 	CatalogConnection    types.String                               `tfsdk:"catalog_connection"`
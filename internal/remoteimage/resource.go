@@ -8,12 +8,19 @@ import (
 	"terraform-provider-parallels-desktop/internal/apiclient"
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
 	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/constants"
 	common_models "terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 	"terraform-provider-parallels-desktop/internal/remoteimage/models"
 	"terraform-provider-parallels-desktop/internal/remoteimage/schemas"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 	"terraform-provider-parallels-desktop/internal/telemetry"
+	"terraform-provider-parallels-desktop/internal/vmplan"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -27,6 +34,7 @@ import (
 var (
 	_ resource.Resource                = &RemoteVmResource{}
 	_ resource.ResourceWithImportState = &RemoteVmResource{}
+	_ resource.ResourceWithModifyPlan  = &RemoteVmResource{}
 )
 
 func NewRemoteVmResource() resource.Resource {
@@ -114,22 +122,24 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
-	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
-		return
-	}
-
-	catalogManifest, catalogManifestDiag := apiclient.GetCatalogManifest(apiCtx, *catalogHostConfig, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString())
+	catalogManifest, resolvedVersion, catalogManifestDiag := resolveCatalogManifest(apiCtx, hostConfig, &data, data.ResolvedVersion.ValueString())
 	if catalogManifestDiag.HasError() {
-		resp.Diagnostics.AddError("Catalog Not Found", fmt.Sprintf("Catalog %s was not found on %s", data.CatalogId.ValueString(), catalogHostConfig.Host))
+		resp.Diagnostics.Append(catalogManifestDiag...)
 		return
 	}
 	// the catalog manifest is nil, we will add an error to the diagnostics
 	if catalogManifest == nil {
-		resp.Diagnostics.AddError("Catalog Not Found", fmt.Sprintf("Catalog %s was not found on %s", data.CatalogId.ValueString(), catalogHostConfig.Host))
+		resp.Diagnostics.AddError("Catalog Not Found", fmt.Sprintf("Catalog %s was not found on %s", data.CatalogId.ValueString(), data.CatalogConnection.ValueString()))
+		return
+	}
+
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error parsing host connection string", err.Error())
 		return
 	}
 
@@ -168,11 +178,12 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	version := catalogManifest.Version
+	data.ManifestId = types.StringValue(catalogManifest.ID)
+	data.ManifestSize = types.Int64Value(catalogManifest.Size)
+	data.ResolvedVersion = types.StringValue(resolvedVersion)
+
+	version := resolvedVersion
 	architecture := catalogManifest.Architecture
-	if data.Version.ValueString() != "" {
-		version = data.Version.ValueString()
-	}
 	if data.Architecture.ValueString() != "" {
 		architecture = data.Architecture.ValueString()
 	}
@@ -185,7 +196,7 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 			CatalogId:      data.CatalogId.ValueString(),
 			Version:        version,
 			Architecture:   architecture,
-			Connection:     data.CatalogConnection.ValueString(),
+			Connection:     catalogConnection,
 			StartAfterPull: data.RunAfterCreate.ValueBool(),
 			Path:           data.Path.ValueString(),
 		},
@@ -195,6 +206,12 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 		createMachineRequest.Owner = data.Owner.ValueString()
 	}
 
+	if placementDiag := placement.Validate(apiCtx, hostConfig, data.Placement, architecture); placementDiag.HasError() {
+		resp.Diagnostics.Append(placementDiag...)
+		return
+	}
+	createMachineRequest.Placement = data.Placement.GetRequest()
+
 	createVmResponse, createVmResponseDiag := apiclient.CreateVm(apiCtx, hostConfig, createMachineRequest)
 	if createVmResponseDiag.HasError() {
 		common.EnsureMachineIsRemoved(apiCtx, hostConfig, data.Name.ValueString())
@@ -222,6 +239,7 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	hostConfig.HostId = createdVM.HostId
+	resp.Diagnostics.Append(placement.VerifyPlacement(apiCtx, hostConfig, data.Placement, createdVM.ID, createdVM.HostId)...)
 
 	// stopping the machine as it might need some operations where the machine needs to be stopped
 	// add anything here in sequence that needs to be done before the machine is started
@@ -394,6 +412,9 @@ func (r *RemoteVmResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	data.OsType = types.StringValue(createdVM.OS)
+	if data.Owner.IsUnknown() {
+		data.Owner = types.StringValue(createdVM.User)
+	}
 	data.ExternalIp = types.StringValue(externalIp)
 	data.InternalIp = types.StringValue(internalIp)
 	data.OrchestratorHostId = types.StringValue(refreshVm.HostId)
@@ -506,6 +527,8 @@ func (r *RemoteVmResource) Read(ctx context.Context, req resource.ReadRequest, r
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(aptCtx, hostConfig, data.ID.ValueString())
@@ -518,9 +541,32 @@ func (r *RemoteVmResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// the resource was imported, rebuilding the state from the machine
+	if data.Name.IsNull() {
+		data.ID = types.StringValue(vm.ID)
+		data.Owner = types.StringValue(vm.User)
+		data.OsType = types.StringValue(vm.OS)
+		data.ExternalIp = types.StringValue(vm.HostExternalIpAddress)
+		data.InternalIp = types.StringValue(vm.InternalIpAddress)
+		data.HostUrl = types.StringValue(vm.HostUrl)
+		data.OrchestratorHostId = types.StringValue(vm.HostId)
+		data.Specs = vmspecs.NewFromVirtualMachine(vm)
+		data.Config = vmconfig.NewFromVirtualMachine(vm)
+		data.SharedFolder = sharedfolder.NewFromVirtualMachine(vm)
+	} else {
+		// picking up any change done to the machine outside terraform
+		if data.Specs != nil {
+			data.Specs.RefreshFromVirtualMachine(vm)
+		}
+		if data.Config != nil {
+			data.Config.RefreshFromVirtualMachine(vm)
+		}
+		data.SharedFolder = sharedfolder.RefreshFromVirtualMachine(vm, data.SharedFolder)
+	}
+
 	data.Name = types.StringValue(vm.Name)
 
-	resp.Diagnostics.Append(req.State.Set(aptCtx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(aptCtx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -579,6 +625,19 @@ func (r *RemoteVmResource) Update(ctx context.Context, req resource.UpdateReques
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	// the catalog manifest could not be resolved during plan, keeping the previous values
+	if data.ManifestId.IsUnknown() {
+		data.ManifestId = currentData.ManifestId
+	}
+	if data.ManifestSize.IsUnknown() {
+		data.ManifestSize = currentData.ManifestSize
+	}
+	if data.ResolvedVersion.IsUnknown() {
+		data.ResolvedVersion = currentData.ResolvedVersion
 	}
 
 	vm, getVmDiag := apiclient.GetVm(aptCtx, hostConfig, currentData.ID.ValueString())
@@ -611,8 +670,9 @@ func (r *RemoteVmResource) Update(ctx context.Context, req resource.UpdateReques
 	configChanges := common.VmConfigBlockHasChanges(aptCtx, hostConfig, vm, data.Config, currentData.Config)
 	specsChanges := common.SpecsBlockHasChanges(aptCtx, hostConfig, vm, data.Specs, currentData.Specs)
 	prlctlChanges := common.PrlCtlBlockHasChanges(aptCtx, hostConfig, vm, data.PrlCtl, currentData.PrlCtl)
+	sharedFoldersChanges := common.SharedFoldersBlockHasChanges(aptCtx, data.SharedFolder, currentData.SharedFolder)
 	postProcessorScriptChanges := common.PostProcessorHasChanges(aptCtx, data.PostProcessorScripts, currentData.PostProcessorScripts)
-	if specsChanges || configChanges || prlctlChanges || nameChanges.HasChanges() {
+	if specsChanges || configChanges || prlctlChanges || sharedFoldersChanges || nameChanges.HasChanges() {
 		requireShutdown = true
 	}
 
@@ -671,6 +731,14 @@ func (r *RemoteVmResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	// Processing shared folders
+	if sharedFoldersChanges {
+		if diag := common.SharedFoldersBlockOnUpdate(aptCtx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
 	// Restarting the machine if needed
 	if needsRestart || (vm.State == "stopped" && currentState == "running") {
 		if newVm, startDiags := common.EnsureMachineRunning(aptCtx, hostConfig, vm); startDiags.HasError() {
@@ -681,12 +749,6 @@ func (r *RemoteVmResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	// Processing shared folders
-	if diag := common.SharedFoldersBlockOnUpdate(aptCtx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
-		resp.Diagnostics.Append(diag...)
-		return
-	}
-
 	// Running the post processor scripts
 	if postProcessorScriptChanges {
 		if diag := common.RunPostProcessorScript(aptCtx, hostConfig, vm, data.PostProcessorScripts); diag.HasError() {
@@ -862,12 +924,133 @@ func (r *RemoteVmResource) Update(ctx context.Context, req resource.UpdateReques
 
 	tflog.Info(aptCtx, "Updated vm with id "+data.ID.ValueString()+" and name "+data.Name.ValueString())
 
+	// the first update after an import has set all the values we could not read from the machine
+	resp.Diagnostics.Append(resp.Private.SetKey(aptCtx, planmodifiers.ImportedPrivateStateKey, nil)...)
+
 	resp.Diagnostics.Append(resp.State.Set(aptCtx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+func (r *RemoteVmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var data models.RemoteVmResourceModelV2
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig, hostConfigDiag := vmplan.GetHostConfig(ctx, r.provider, req.Plan)
+	resp.Diagnostics.Append(hostConfigDiag...)
+	if resp.Diagnostics.HasError() || hostConfig == nil {
+		return
+	}
+
+	var currentData *models.RemoteVmResourceModelV2
+	if !req.State.Raw.IsNull() {
+		currentData = &models.RemoteVmResourceModelV2{}
+		resp.Diagnostics.Append(req.State.Get(ctx, currentData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// resolving the catalog manifest so a wrong catalog id, version or architecture fails during plan
+	architecture := data.Architecture.ValueString()
+	upgradeOnNewVersion := data.UpgradePolicy.ValueString() == constants.UpgradePolicyOnNewVersion
+	if currentData == nil || currentData.ManifestId.IsNull() || currentData.ResolvedVersion.IsNull() || catalogTargetChanged(&data, currentData) || upgradeOnNewVersion {
+		// unless we follow new versions we keep the current one while it still matches the requested version
+		pinnedVersion := ""
+		if currentData != nil && !upgradeOnNewVersion &&
+			data.CatalogId.ValueString() == currentData.CatalogId.ValueString() &&
+			data.Architecture.ValueString() == currentData.Architecture.ValueString() {
+			pinnedVersion = currentData.ResolvedVersion.ValueString()
+		}
+
+		catalogManifest, resolvedVersion, manifestDiag := resolveCatalogManifest(ctx, *hostConfig, &data, pinnedVersion)
+		resp.Diagnostics.Append(manifestDiag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if catalogManifest != nil {
+			if architecture == "" {
+				architecture = catalogManifest.Architecture
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest_id"), types.StringValue(catalogManifest.ID))...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest_size"), types.Int64Value(catalogManifest.Size))...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_version"), types.StringValue(resolvedVersion))...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// a different version of the image means a new machine
+			if currentData != nil && !currentData.ResolvedVersion.IsNull() && currentData.ResolvedVersion.ValueString() != resolvedVersion {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root("resolved_version"))
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(vmplan.Check(ctx, *hostConfig, req, vmplan.Options{Architecture: architecture})...)
+}
+
+// catalogTargetChanged returns true if any of the values used to find the catalog manifest changed
+func catalogTargetChanged(data, currentData *models.RemoteVmResourceModelV2) bool {
+	return data.CatalogId.ValueString() != currentData.CatalogId.ValueString() ||
+		data.Version.ValueString() != currentData.Version.ValueString() ||
+		data.Architecture.ValueString() != currentData.Architecture.ValueString() ||
+		data.CatalogConnection.ValueString() != currentData.CatalogConnection.ValueString()
+}
+
+// resolveCatalogManifest resolves the requested catalog version and gets the catalog manifest the machine will be
+// created from, checking if the target host can run it. It returns nil if the values are only known after apply
+func resolveCatalogManifest(ctx context.Context, hostConfig apiclient.HostConfig, data *models.RemoteVmResourceModelV2, pinnedVersion string) (*apimodels.CatalogManifest, string, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	if data.CatalogId.IsUnknown() || data.Version.IsUnknown() || data.Architecture.IsUnknown() || data.CatalogConnection.IsUnknown() {
+		return nil, "", diagnostics
+	}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("catalog_connection"), "error parsing host connection string", err.Error())
+		return nil, "", diagnostics
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || hostConfig.DisableTlsValidation
+
+	version, versionDiag := common.ResolveCatalogVersion(ctx, *catalogHostConfig, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString(), pinnedVersion)
+	if versionDiag.HasError() {
+		diagnostics.Append(versionDiag...)
+		return nil, "", diagnostics
+	}
+
+	catalogManifest, catalogManifestDiag := apiclient.GetCatalogManifest(ctx, *catalogHostConfig, data.CatalogId.ValueString(), version, data.Architecture.ValueString())
+	if catalogManifestDiag.HasError() {
+		diagnostics.Append(catalogManifestDiag...)
+		return nil, "", diagnostics
+	}
+	if catalogManifest == nil {
+		diagnostics.AddAttributeError(path.Root("catalog_id"), "Catalog Not Found", fmt.Sprintf("Catalog %s version %s was not found on %s", data.CatalogId.ValueString(), version, catalogHostConfig.Host))
+		return nil, "", diagnostics
+	}
+
+	if archDiag := common.CheckHostArchitecture(ctx, hostConfig, catalogManifest.Architecture); archDiag.HasError() {
+		diagnostics.Append(archDiag...)
+		return nil, "", diagnostics
+	}
+
+	// the catalog answered with the real version when we asked for the latest one
+	if version == constants.CatalogVersionLatest && catalogManifest.Version != "" {
+		version = catalogManifest.Version
+	}
+
+	return catalogManifest, version, diagnostics
+}
+
 func (r *RemoteVmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data models.RemoteVmResourceModelV2
 
@@ -921,6 +1104,8 @@ func (r *RemoteVmResource) Delete(ctx context.Context, req resource.DeleteReques
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(apiCtx, hostConfig, data.ID.ValueString())
@@ -993,7 +1178,7 @@ func (r *RemoteVmResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *RemoteVmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	common.ImportVmState(ctx, r.provider, req, resp)
 }
 
 func (r *RemoteVmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -1138,11 +1323,18 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *models.RemoteVmRes
 			host.TcpRoute.TargetPort = data.ReverseProxyHosts[i].TcpRoute.TargetPort
 			host.TcpRoute.TargetHost = types.StringValue(internalIp)
 			host.TcpRoute.TargetVmId = types.StringValue(emptyString)
+			host.TcpRoute.HealthCheck = data.ReverseProxyHosts[i].TcpRoute.HealthCheck
+			// a route with targets balances between them instead of routing to this machine
+			if len(data.ReverseProxyHosts[i].TcpRoute.Targets) > 0 {
+				host.TcpRoute.TargetHost = types.StringValue(emptyString)
+				host.TcpRoute.Targets = data.ReverseProxyHosts[i].TcpRoute.Targets
+				host.TcpRoute.LoadBalancing = data.ReverseProxyHosts[i].TcpRoute.LoadBalancing
+			}
 		}
 
 		if len(data.ReverseProxyHosts[i].HttpRoute) > 0 {
 			host.HttpRoute = make([]*reverseproxy.ReverseProxyHttpRoute, len(data.ReverseProxyHosts[i].HttpRoute))
-			for j := range modifiedHosts[i].HttpRoute {
+			for j := range data.ReverseProxyHosts[i].HttpRoute {
 				httpRoute := reverseproxy.ReverseProxyHttpRoute{}
 				httpRoute.Path = data.ReverseProxyHosts[i].HttpRoute[j].Path
 				httpRoute.TargetHost = types.StringValue(internalIp)
@@ -1152,6 +1344,12 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *models.RemoteVmRes
 				httpRoute.Schema = data.ReverseProxyHosts[i].HttpRoute[j].Schema
 				httpRoute.RequestHeaders = data.ReverseProxyHosts[i].HttpRoute[j].RequestHeaders
 				httpRoute.ResponseHeaders = data.ReverseProxyHosts[i].HttpRoute[j].ResponseHeaders
+				httpRoute.HealthCheck = data.ReverseProxyHosts[i].HttpRoute[j].HealthCheck
+				if len(data.ReverseProxyHosts[i].HttpRoute[j].Targets) > 0 {
+					httpRoute.TargetHost = types.StringValue(emptyString)
+					httpRoute.Targets = data.ReverseProxyHosts[i].HttpRoute[j].Targets
+					httpRoute.LoadBalancing = data.ReverseProxyHosts[i].HttpRoute[j].LoadBalancing
+				}
 				host.HttpRoute[j] = &httpRoute
 			}
 		}
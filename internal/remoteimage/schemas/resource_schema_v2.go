@@ -3,18 +3,24 @@ package schemas
 import (
 	"context"
 
+	"terraform-provider-parallels-desktop/internal/constants"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
+	"terraform-provider-parallels-desktop/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,6 +32,8 @@ func GetRemoteImageSchemaV2(ctx context.Context) schema.Schema {
 		MarkdownDescription: "Parallels Virtual Machine State Resource",
 		Blocks: map[string]schema.Block{
 			authenticator.SchemaName:       authenticator.SchemaBlock,
+			sshtunnel.SchemaName:           sshtunnel.SchemaBlock,
+			placement.SchemaName:           placement.SchemaBlock,
 			vmspecs.SchemaName:             vmspecs.SchemaBlock,
 			postprocessorscript.SchemaName: postprocessorscript.SchemaBlock,
 			"on_destroy_script":            postprocessorscript.SchemaBlock,
@@ -85,18 +93,46 @@ func GetRemoteImageSchemaV2(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Catalog Id to pull",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"version": schema.StringAttribute{
-				MarkdownDescription: "Catalog version to pull, if empty will pull the 'latest' version",
+				MarkdownDescription: "Catalog version to pull, it can be an exact version, `latest` or a version constraint like `~> 1.2` or `>= 2.0, < 3.0`. If empty will pull the 'latest' version",
 				Optional:            true,
 			},
+			"resolved_version": schema.StringAttribute{
+				MarkdownDescription: "Catalog version resolved from the version attribute",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"upgrade_policy": schema.StringAttribute{
+				MarkdownDescription: "Controls what happens when a new catalog version matching the version attribute is published. `pin` keeps the current version until the version attribute no longer matches it, `on_new_version` replaces the machine with the new version on the next plan. Defaults to `pin`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(constants.UpgradePolicyPin, constants.UpgradePolicyOnNewVersion),
+				},
+			},
 			"architecture": schema.StringAttribute{
 				MarkdownDescription: "Virtual Machine architecture",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+			},
+			"manifest_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the catalog manifest resolved from the catalog id, version and architecture",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manifest_size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the catalog manifest resolved from the catalog id, version and architecture",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -106,7 +142,9 @@ func GetRemoteImageSchemaV2(ctx context.Context) schema.Schema {
 			"owner": schema.StringAttribute{
 				MarkdownDescription: "Virtual Machine owner",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -114,14 +152,17 @@ func GetRemoteImageSchemaV2(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Parallels DevOps Catalog Connection",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
+				},
+				Validators: []validator.String{
+					validators.CatalogConnection(),
 				},
 			},
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Path",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"run_after_create": schema.BoolAttribute{
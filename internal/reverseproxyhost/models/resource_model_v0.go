@@ -0,0 +1,312 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// allAddress is the address the reverse proxy uses when the host or target host is not set
+const allAddress = "0.0.0.0"
+
+// ReverseProxyHostResourceModelV0 describes the resource data model.
+type ReverseProxyHostResourceModelV0 struct {
+	Authenticator *authenticator.Authentication   `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel            `tfsdk:"ssh_tunnel"`
+	Host          types.String                    `tfsdk:"host"`
+	Orchestrator  types.String                    `tfsdk:"orchestrator"`
+	HostId        types.String                    `tfsdk:"host_id"`
+	ID            types.String                    `tfsdk:"id"`
+	ProxyHost     types.String                    `tfsdk:"proxy_host"`
+	Port          types.String                    `tfsdk:"port"`
+	Cors          *ReverseProxyCorsModelV0        `tfsdk:"cors"`
+	Tls           *ReverseProxyTlsModelV0         `tfsdk:"tls"`
+	HttpRoutes    []*ReverseProxyHttpRouteModelV0 `tfsdk:"http_routes"`
+	TcpRoute      *ReverseProxyTcpRouteModelV0    `tfsdk:"tcp_route"`
+}
+
+type ReverseProxyCorsModelV0 struct {
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+}
+
+type ReverseProxyTlsModelV0 struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Certificate types.String `tfsdk:"certificate"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+}
+
+type ReverseProxyHttpRouteModelV0 struct {
+	TargetPort      types.String                          `tfsdk:"target_port"`
+	TargetHost      types.String                          `tfsdk:"target_host"`
+	TargetVmId      types.String                          `tfsdk:"target_vm_id"`
+	Path            types.String                          `tfsdk:"path"`
+	Pattern         types.String                          `tfsdk:"pattern"`
+	Schema          types.String                          `tfsdk:"schema"`
+	RequestHeaders  map[string]types.String               `tfsdk:"request_headers"`
+	ResponseHeaders map[string]types.String               `tfsdk:"response_headers"`
+	Targets         []*reverseproxy.ReverseProxyTarget    `tfsdk:"targets"`
+	LoadBalancing   types.String                          `tfsdk:"load_balancing"`
+	HealthCheck     *reverseproxy.ReverseProxyHealthCheck `tfsdk:"health_check"`
+}
+
+type ReverseProxyTcpRouteModelV0 struct {
+	TargetPort    types.String                          `tfsdk:"target_port"`
+	TargetHost    types.String                          `tfsdk:"target_host"`
+	TargetVmId    types.String                          `tfsdk:"target_vm_id"`
+	Targets       []*reverseproxy.ReverseProxyTarget    `tfsdk:"targets"`
+	LoadBalancing types.String                          `tfsdk:"load_balancing"`
+	HealthCheck   *reverseproxy.ReverseProxyHealthCheck `tfsdk:"health_check"`
+}
+
+// GetTargetVmIds returns the ids of the machines the routes point to, including the route targets
+func (m *ReverseProxyHostResourceModelV0) GetTargetVmIds() []string {
+	result := make([]string, 0)
+	for _, route := range m.HttpRoutes {
+		if route == nil {
+			continue
+		}
+		if common.GetString(route.TargetVmId) != "" {
+			result = append(result, route.TargetVmId.ValueString())
+		}
+		result = append(result, getTargetsVmIds(route.Targets)...)
+	}
+	if m.TcpRoute != nil {
+		if common.GetString(m.TcpRoute.TargetVmId) != "" {
+			result = append(result, m.TcpRoute.TargetVmId.ValueString())
+		}
+		result = append(result, getTargetsVmIds(m.TcpRoute.Targets)...)
+	}
+
+	return result
+}
+
+func getTargetsVmIds(targets []*reverseproxy.ReverseProxyTarget) []string {
+	result := make([]string, 0)
+	for _, target := range targets {
+		if target != nil && common.GetString(target.VmId) != "" {
+			result = append(result, target.VmId.ValueString())
+		}
+	}
+
+	return result
+}
+
+// ToReverseProxyHost maps the resource to the reverse proxy host used by the reverse proxy operations
+func (m *ReverseProxyHostResourceModelV0) ToReverseProxyHost() reverseproxy.ReverseProxyHost {
+	result := reverseproxy.ReverseProxyHost{
+		ID:   m.ID,
+		Host: m.ProxyHost,
+		Port: m.Port.ValueString(),
+	}
+
+	if m.Cors != nil {
+		result.Cors = &reverseproxy.ReverseProxyCors{
+			Enabled:        m.Cors.Enabled.ValueBool(),
+			AllowedOrigins: nilIfEmpty(common.GetStrings(m.Cors.AllowedOrigins)),
+			AllowedMethods: nilIfEmpty(common.GetStrings(m.Cors.AllowedMethods)),
+			AllowedHeaders: nilIfEmpty(common.GetStrings(m.Cors.AllowedHeaders)),
+		}
+	}
+	if m.Tls != nil {
+		result.Tls = &reverseproxy.ReverseProxyTls{
+			Enabled:     m.Tls.Enabled.ValueBool(),
+			Certificate: m.Tls.Certificate.ValueString(),
+			PrivateKey:  m.Tls.PrivateKey.ValueString(),
+		}
+	}
+	for _, route := range m.HttpRoutes {
+		if route == nil {
+			continue
+		}
+		result.HttpRoute = append(result.HttpRoute, &reverseproxy.ReverseProxyHttpRoute{
+			TargetPort:      route.TargetPort,
+			TargetHost:      route.TargetHost,
+			TargetVmId:      route.TargetVmId,
+			Path:            route.Path.ValueString(),
+			Pattern:         route.Pattern.ValueString(),
+			Schema:          route.Schema.ValueString(),
+			RequestHeaders:  toStringMap(route.RequestHeaders),
+			ResponseHeaders: toStringMap(route.ResponseHeaders),
+			Targets:         route.Targets,
+			LoadBalancing:   route.LoadBalancing,
+			HealthCheck:     route.HealthCheck,
+		})
+	}
+	if m.TcpRoute != nil {
+		result.TcpRoute = &reverseproxy.ReverseProxyHostTcpRoute{
+			TargetPort:    m.TcpRoute.TargetPort,
+			TargetHost:    m.TcpRoute.TargetHost,
+			TargetVmId:    m.TcpRoute.TargetVmId,
+			Targets:       m.TcpRoute.Targets,
+			LoadBalancing: m.TcpRoute.LoadBalancing,
+			HealthCheck:   m.TcpRoute.HealthCheck,
+		}
+	}
+
+	return result
+}
+
+// FromApiModel refreshes the resource from the reverse proxy host returned by the api, values the
+// api fills in or does not return, like the default target host or the tls private key, are only
+// taken from the api if they were set in the resource
+func (m *ReverseProxyHostResourceModelV0) FromApiModel(host *apimodels.ReverseProxyHost) {
+	m.ID = types.StringValue(host.ID)
+	m.Port = types.StringValue(host.Port)
+	if host.Host == "" {
+		m.ProxyHost = types.StringValue(allAddress)
+	} else {
+		m.ProxyHost = types.StringValue(host.Host)
+	}
+
+	if host.Cors == nil {
+		m.Cors = nil
+	} else {
+		prior := m.Cors
+		if prior == nil {
+			prior = &ReverseProxyCorsModelV0{}
+		}
+		m.Cors = &ReverseProxyCorsModelV0{
+			Enabled:        readBool(host.Cors.Enabled, prior.Enabled),
+			AllowedOrigins: readStrings(host.Cors.AllowedOrigins),
+			AllowedMethods: readStrings(host.Cors.AllowedMethods),
+			AllowedHeaders: readStrings(host.Cors.AllowedHeaders),
+		}
+	}
+
+	if host.Tls == nil {
+		m.Tls = nil
+	} else {
+		prior := m.Tls
+		if prior == nil {
+			prior = &ReverseProxyTlsModelV0{}
+		}
+		m.Tls = &ReverseProxyTlsModelV0{
+			Enabled:     readBool(host.Tls.Enabled, prior.Enabled),
+			Certificate: readSecret(host.Tls.Cert, prior.Certificate),
+			PrivateKey:  readSecret(host.Tls.Key, prior.PrivateKey),
+		}
+	}
+
+	priorRoutes := m.HttpRoutes
+	m.HttpRoutes = nil
+	for i, route := range host.HttpRoutes {
+		if route == nil {
+			continue
+		}
+		prior := &ReverseProxyHttpRouteModelV0{}
+		if i < len(priorRoutes) && priorRoutes[i] != nil {
+			prior = priorRoutes[i]
+		}
+		m.HttpRoutes = append(m.HttpRoutes, &ReverseProxyHttpRouteModelV0{
+			TargetPort:      readString(route.TargetPort, prior.TargetPort, ""),
+			TargetHost:      readString(route.TargetHost, prior.TargetHost, allAddress),
+			TargetVmId:      readString(route.TargetVmId, prior.TargetVmId, ""),
+			Path:            readString(route.Path, prior.Path, ""),
+			Pattern:         readString(route.Pattern, prior.Pattern, ""),
+			Schema:          readString(route.Schema, prior.Schema, ""),
+			RequestHeaders:  readStringMap(route.RequestHeaders),
+			ResponseHeaders: readStringMap(route.ResponseHeaders),
+			Targets:         reverseproxy.MapTargetsFromApiModel(route.Targets, prior.Targets),
+			LoadBalancing:   readString(route.LoadBalancing, prior.LoadBalancing, ""),
+			HealthCheck:     reverseproxy.MapHealthCheckFromApiModel(route.HealthCheck, prior.HealthCheck),
+		})
+	}
+
+	if host.TcpRoute == nil || (host.TcpRoute.TargetHost == "" && host.TcpRoute.TargetPort == "" && host.TcpRoute.TargetVmId == "" && len(host.TcpRoute.Targets) == 0) {
+		m.TcpRoute = nil
+	} else {
+		prior := m.TcpRoute
+		if prior == nil {
+			prior = &ReverseProxyTcpRouteModelV0{}
+		}
+		m.TcpRoute = &ReverseProxyTcpRouteModelV0{
+			TargetPort:    readString(host.TcpRoute.TargetPort, prior.TargetPort, ""),
+			TargetHost:    readString(host.TcpRoute.TargetHost, prior.TargetHost, allAddress),
+			TargetVmId:    readString(host.TcpRoute.TargetVmId, prior.TargetVmId, ""),
+			Targets:       reverseproxy.MapTargetsFromApiModel(host.TcpRoute.Targets, prior.Targets),
+			LoadBalancing: readString(host.TcpRoute.LoadBalancing, prior.LoadBalancing, ""),
+			HealthCheck:   reverseproxy.MapHealthCheckFromApiModel(host.TcpRoute.HealthCheck, prior.HealthCheck),
+		}
+	}
+}
+
+// readString returns null for empty values and for the api default value when it was not set
+func readString(value string, prior types.String, defaultValue string) types.String {
+	if value == "" || (defaultValue != "" && value == defaultValue && common.GetString(prior) == "") {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// readSecret keeps the prior value when the api does not return the secret
+func readSecret(value string, prior types.String) types.String {
+	if value == "" {
+		return prior
+	}
+
+	return types.StringValue(value)
+}
+
+// readBool returns null for a disabled flag that was not set, the api omits false values
+func readBool(value bool, prior types.Bool) types.Bool {
+	if !value && prior.IsNull() {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(value)
+}
+
+func readStrings(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
+
+func readStringMap(values map[string]string) map[string]types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]types.String, len(values))
+	for key, value := range values {
+		result[key] = types.StringValue(value)
+	}
+
+	return result
+}
+
+func toStringMap(values map[string]types.String) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = value.ValueString()
+	}
+
+	return result
+}
+
+func nilIfEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
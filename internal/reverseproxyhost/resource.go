@@ -0,0 +1,349 @@
+package reverseproxyhost
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	resource_models "terraform-provider-parallels-desktop/internal/reverseproxyhost/models"
+	"terraform-provider-parallels-desktop/internal/reverseproxyhost/schemas"
+	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ReverseProxyHostResource{}
+	_ resource.ResourceWithImportState = &ReverseProxyHostResource{}
+	_ resource.ResourceWithModifyPlan  = &ReverseProxyHostResource{}
+)
+
+func NewReverseProxyHostResource() resource.Resource {
+	return &ReverseProxyHostResource{}
+}
+
+// ReverseProxyHostResource defines the resource implementation.
+type ReverseProxyHostResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *ReverseProxyHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + reverseproxy.SchemaName
+}
+
+func (r *ReverseProxyHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetReverseProxyHostSchemaV0(ctx)
+}
+
+func (r *ReverseProxyHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *ReverseProxyHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.ReverseProxyHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventReverseProxyHost, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig, configDiag := r.getHostConfig(data)
+	if configDiag.HasError() {
+		resp.Diagnostics.Append(configDiag...)
+		return
+	}
+
+	if vmDiag := validateTargetVms(ctx, hostConfig, data); vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+
+	tflog.Info(ctx, "Creating reverse proxy host "+data.ProxyHost.ValueString()+":"+data.Port.ValueString())
+	hosts, createDiag := reverseproxy.Create(ctx, hostConfig, []reverseproxy.ReverseProxyHost{data.ToReverseProxyHost()})
+	if createDiag.HasError() {
+		resp.Diagnostics.Append(createDiag...)
+		return
+	}
+
+	data.ID = hosts[0].ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ReverseProxyHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.ReverseProxyHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventReverseProxyHost, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig, configDiag := r.getHostConfig(data)
+	if configDiag.HasError() {
+		resp.Diagnostics.Append(configDiag...)
+		return
+	}
+
+	host, hostDiag := apiclient.GetReverseProxyHost(ctx, hostConfig, data.ID.ValueString())
+	if hostDiag.HasError() {
+		resp.Diagnostics.Append(hostDiag...)
+		return
+	}
+
+	// the reverse proxy host was removed outside terraform, it needs to be created again
+	if host == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.FromApiModel(host)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ReverseProxyHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.ReverseProxyHostResourceModelV0
+	var currentData resource_models.ReverseProxyHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventReverseProxyHost, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig, configDiag := r.getHostConfig(data)
+	if configDiag.HasError() {
+		resp.Diagnostics.Append(configDiag...)
+		return
+	}
+
+	if vmDiag := validateTargetVms(ctx, hostConfig, data); vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+
+	tflog.Info(ctx, "Updating reverse proxy host "+currentData.ID.ValueString())
+	hosts, updateDiag := reverseproxy.Update(ctx, hostConfig,
+		[]reverseproxy.ReverseProxyHost{currentData.ToReverseProxyHost()},
+		[]reverseproxy.ReverseProxyHost{data.ToReverseProxyHost()},
+	)
+	if updateDiag.HasError() {
+		resp.Diagnostics.Append(updateDiag...)
+		return
+	}
+
+	// the reverse proxy host is recreated when it changes, if nothing changed the id is kept
+	if len(hosts) > 0 {
+		data.ID = hosts[0].ID
+	} else {
+		data.ID = currentData.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ReverseProxyHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var data resource_models.ReverseProxyHostResourceModelV0
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentHosts := make([]reverseproxy.ReverseProxyHost, 0)
+	owner := ""
+	if !req.State.Raw.IsNull() {
+		var currentData resource_models.ReverseProxyHostResourceModelV0
+		resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		currentHost := currentData.ToReverseProxyHost()
+		currentHosts = append(currentHosts, currentHost)
+		owner = currentData.ID.ValueString()
+
+		// the listener is recreated when it changes, its new id is only known after the update
+		plannedHost := data.ToReverseProxyHost()
+		if currentHost.Diff(&plannedHost) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		}
+	}
+
+	// the host or the listener might only be known once other resources are created
+	if data.Host.IsUnknown() || data.Orchestrator.IsUnknown() || data.HostId.IsUnknown() || data.ProxyHost.IsUnknown() || data.Port.IsUnknown() {
+		return
+	}
+
+	hostConfig, configDiag := r.getHostConfig(data)
+	if configDiag.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(reverseproxy.CheckConflicts(ctx, hostConfig, owner,
+		[]reverseproxy.ReverseProxyHost{data.ToReverseProxyHost()},
+		currentHosts,
+		func(int) path.Path { return path.Empty() },
+	)...)
+}
+
+func (r *ReverseProxyHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.ReverseProxyHostResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventReverseProxyHost, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig, configDiag := r.getHostConfig(data)
+	if configDiag.HasError() {
+		resp.Diagnostics.Append(configDiag...)
+		return
+	}
+
+	if deleteDiag := reverseproxy.Delete(ctx, hostConfig, []reverseproxy.ReverseProxyHost{data.ToReverseProxyHost()}); deleteDiag.HasError() {
+		resp.Diagnostics.Append(deleteDiag...)
+		return
+	}
+}
+
+// ImportState sets the host, credentials and reverse proxy host id from the import id in the format
+// [username:password@]host@reverse_proxy_host_id, the Read then rebuilds the rest of the state
+func (r *ReverseProxyHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, auth, hostId, err := common.ParseImportId(req.ID, "reverse_proxy_host_id", "reverse proxy host id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hostId)...)
+	if auth != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), auth)...)
+	}
+}
+
+func (r *ReverseProxyHostResource) getHostConfig(data resource_models.ReverseProxyHostResourceModelV0) (apiclient.HostConfig, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return apiclient.HostConfig{}, diagnostics
+	}
+	if isOrchestrator && data.HostId.ValueString() == "" {
+		diagnostics.AddAttributeError(path.Root("host_id"), "host_id cannot be empty", "The orchestrator host running the reverse proxy is required when using an orchestrator")
+		return apiclient.HostConfig{}, diagnostics
+	}
+
+	return apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		HostId:               data.HostId.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}, diagnostics
+}
+
+// validateTargetVms checks the machines the routes point to exist, the reverse proxy resolves
+// their internal address when routing so a missing machine would only fail at request time
+func validateTargetVms(ctx context.Context, hostConfig apiclient.HostConfig, data resource_models.ReverseProxyHostResourceModelV0) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	for _, vmId := range data.GetTargetVmIds() {
+		vm, vmDiag := apiclient.GetVm(ctx, hostConfig, vmId)
+		if vmDiag.HasError() {
+			diagnostics.Append(vmDiag...)
+			continue
+		}
+		if vm == nil {
+			diagnostics.AddError("Target VM not found", "Could not find the VM with ID "+vmId+" the reverse proxy routes to")
+		}
+	}
+
+	return diagnostics
+}
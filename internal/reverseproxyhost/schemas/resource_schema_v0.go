@@ -0,0 +1,86 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func GetReverseProxyHostSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Parallels Desktop DevOps Reverse Proxy Host Resource\n Use this to manage a reverse proxy host independently of the virtual machines it routes to, routes can target machines by id and the reverse proxy will resolve their internal address.",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+			"tcp_route":              reverseproxy.TcpRouteSchemaBlockV0,
+			"cors":                   reverseproxy.CorsSchemaBlockV0,
+			"tls":                    reverseproxy.TlsSchemaBlockV0,
+			"http_routes":            reverseproxy.HttpRouteSchemaBlockV0,
+		},
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+			},
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_id": schema.StringAttribute{
+				MarkdownDescription: "Orchestrator host id running the reverse proxy, required when using an orchestrator",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("orchestrator")),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Reverse proxy Host id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proxy_host": schema.StringAttribute{
+				MarkdownDescription: "Address the reverse proxy host listens on, defaults to `0.0.0.0`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0.0.0.0"),
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Port the reverse proxy host listens on",
+				Required:            true,
+			},
+		},
+	}
+}
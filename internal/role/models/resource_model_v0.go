@@ -0,0 +1,29 @@
+package models
+
+import (
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoleResourceModelV0 represents the resource schema for the role resource.
+type RoleResourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Name          types.String                  `tfsdk:"name"`
+}
+
+// FromApiModel sets the id and name from the host, the configured name is kept when it only
+// differs in case as the host normalizes role names
+func (m *RoleResourceModelV0) FromApiModel(role *apimodels.ClaimRoleResponse) {
+	m.ID = types.StringValue(role.ID)
+	if !strings.EqualFold(m.Name.ValueString(), role.Name) {
+		m.Name = types.StringValue(role.Name)
+	}
+}
@@ -2,6 +2,7 @@ package authenticator
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	action_schema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,4 +40,35 @@ var (
 			},
 		},
 	}
+
+	// ActionSchemaBlock is the same authenticator block for actions, they use their own schema types
+	// and action configurations are never stored so there is no need to mark the secrets as sensitive
+	ActionSchemaBlock = action_schema.SingleNestedBlock{
+		MarkdownDescription: SchemaBlock.MarkdownDescription,
+		Description:         SchemaBlock.Description,
+		Attributes: map[string]action_schema.Attribute{
+			"username": action_schema.StringAttribute{
+				MarkdownDescription: "Parallels desktop API Username",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRelative().AtName("password").AtParent(),
+					}...),
+				},
+			},
+			"password": action_schema.StringAttribute{
+				MarkdownDescription: "Parallels desktop API Password",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRelative().AtName("username").AtParent(),
+					}...),
+				},
+			},
+			"api_key": action_schema.StringAttribute{
+				MarkdownDescription: "Parallels desktop API Key",
+				Optional:            true,
+			},
+		},
+	}
 )
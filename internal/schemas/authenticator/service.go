@@ -9,13 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func GetAuthenticator(ctx context.Context, host string, license string, authenticator *Authentication, disableTLSVerification bool) (*helpers.HttpCallerAuth, error) {
-	client := helpers.NewHttpCaller(ctx, disableTLSVerification)
+// GetAuthenticator returns the authentication to use with the host api, apiBaseUrl is the versioned
+// api url of the host and the client the http caller configured with the host tls settings
+func GetAuthenticator(ctx context.Context, client *helpers.HttpCaller, apiBaseUrl string, license string, authenticator *Authentication) (*helpers.HttpCallerAuth, error) {
 	var auth helpers.HttpCallerAuth
 	if authenticator == nil {
 		tflog.Info(ctx, "Authenticator is nil, using root access")
 		password := license
-		token, err := client.GetJwtToken(ctx, host, constants.RootUser, password)
+		token, err := client.GetJwtToken(ctx, apiBaseUrl, constants.RootUser, password)
 		if err != nil {
 			return nil, err
 		}
@@ -27,7 +28,7 @@ func GetAuthenticator(ctx context.Context, host string, license string, authenti
 	} else {
 		if authenticator.Username.ValueString() != "" {
 			password := authenticator.Password.ValueString()
-			token, err := client.GetJwtToken(ctx, host, authenticator.Username.ValueString(), password)
+			token, err := client.GetJwtToken(ctx, apiBaseUrl, authenticator.Username.ValueString(), password)
 			if err != nil {
 				return nil, err
 			}
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
-func RegisterWithHost(context context.Context, plan OrchestratorRegistration, disableTlsValidation bool) (string, diag.Diagnostics) {
+func RegisterWithHost(context context.Context, plan OrchestratorRegistration, disableTlsValidation bool, apiPrefix string) (string, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	if updateDiags := UpdateFromDetails(context, &plan); updateDiags.HasError() {
 		diagnostics.Append(updateDiags...)
@@ -61,6 +61,7 @@ func RegisterWithHost(context context.Context, plan OrchestratorRegistration, di
 		Host:                 plan.Orchestrator.GetHost(),
 		Authorization:        plan.Orchestrator.UseAuthentication,
 		DisableTlsValidation: disableTlsValidation,
+		ApiPrefix:            apiPrefix,
 	}
 
 	response, diag := apiclient.RegisterWithOrchestrator(context, hostConfig, orchestratorRequest)
@@ -76,7 +77,7 @@ func RegisterWithHost(context context.Context, plan OrchestratorRegistration, di
 	return "", diagnostics
 }
 
-func IsAlreadyRegistered(context context.Context, data OrchestratorRegistration, disableTlsValidation bool) (bool, *apimodels.OrchestratorHost, diag.Diagnostics) {
+func IsAlreadyRegistered(context context.Context, data OrchestratorRegistration, disableTlsValidation bool, apiPrefix string) (bool, *apimodels.OrchestratorHost, diag.Diagnostics) {
 	diagnostics := diag.Diagnostics{}
 	if data.Orchestrator == nil {
 		return false, nil, diagnostics
@@ -90,10 +91,11 @@ func IsAlreadyRegistered(context context.Context, data OrchestratorRegistration,
 			ApiKey:   data.Orchestrator.UseAuthentication.ApiKey,
 		},
 		DisableTlsValidation: disableTlsValidation,
+		ApiPrefix:            apiPrefix,
 	}
 
 	currentHostId := data.HostId.ValueString()
-	currentHostUrl := helpers.GetHostApiBaseUrl(data.GetHost())
+	currentHostUrl := helpers.GetHostApiBaseUrlWithPrefixAndVersion(data.GetHost(), data.ApiPrefix, "")
 	currentHostDescription := data.Description.ValueString()
 	response, _ := apiclient.GetOrchestratorHosts(context, hostConfig)
 	if response == nil {
@@ -115,7 +117,7 @@ func IsAlreadyRegistered(context context.Context, data OrchestratorRegistration,
 	return false, nil, diagnostics
 }
 
-func UnregisterWithHost(context context.Context, data OrchestratorRegistration, disableTlsValidation bool) diag.Diagnostics {
+func UnregisterWithHost(context context.Context, data OrchestratorRegistration, disableTlsValidation bool, apiPrefix string) diag.Diagnostics {
 	diagnostics := diag.Diagnostics{}
 
 	hostConfig := apiclient.HostConfig{
@@ -126,6 +128,7 @@ func UnregisterWithHost(context context.Context, data OrchestratorRegistration,
 			ApiKey:   data.Orchestrator.UseAuthentication.ApiKey,
 		},
 		DisableTlsValidation: disableTlsValidation,
+		ApiPrefix:            apiPrefix,
 	}
 
 	_ = UpdateFromDetails(context, &data)
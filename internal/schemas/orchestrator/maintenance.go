@@ -0,0 +1,42 @@
+package orchestrator
+
+import (
+	"strings"
+	"sync"
+)
+
+// maintenanceOwners keeps the resources managing the maintenance mode of the orchestrator hosts
+// planned while the provider runs, a host_drain and an orchestrator_host resource of the same host
+// would keep toggling it
+var (
+	maintenanceOwners      = []maintenanceOwner{}
+	maintenanceOwnersMutex sync.Mutex
+)
+
+type maintenanceOwner struct {
+	orchestrator string
+	hostId       string
+	resourceType string
+}
+
+// ClaimHostMaintenance records that the resource type manages the maintenance mode of the host and
+// returns the other resource type already managing it, or an empty string if there is none
+func ClaimHostMaintenance(orchestrator string, hostId string, resourceType string) string {
+	if hostId == "" {
+		return ""
+	}
+
+	orchestrator = strings.ToLower(strings.TrimSuffix(orchestrator, "/"))
+
+	maintenanceOwnersMutex.Lock()
+	defer maintenanceOwnersMutex.Unlock()
+
+	for _, owner := range maintenanceOwners {
+		if owner.orchestrator == orchestrator && strings.EqualFold(owner.hostId, hostId) && owner.resourceType != resourceType {
+			return owner.resourceType
+		}
+	}
+
+	maintenanceOwners = append(maintenanceOwners, maintenanceOwner{orchestrator: orchestrator, hostId: hostId, resourceType: resourceType})
+	return ""
+}
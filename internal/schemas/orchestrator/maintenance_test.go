@@ -0,0 +1,33 @@
+package orchestrator
+
+import (
+	"testing"
+)
+
+func TestClaimHostMaintenance(t *testing.T) {
+	maintenanceOwners = []maintenanceOwner{}
+	t.Cleanup(func() { maintenanceOwners = []maintenanceOwner{} })
+
+	tests := []struct {
+		name         string
+		orchestrator string
+		hostId       string
+		resourceType string
+		want         string
+	}{
+		{"first resource claims the host", "https://orchestrator.example.com", "host-1", "parallels-desktop_orchestrator_host", ""},
+		{"same resource type claims the host again", "https://orchestrator.example.com", "host-1", "parallels-desktop_orchestrator_host", ""},
+		{"other resource type conflicts", "https://orchestrator.example.com/", "HOST-1", "parallels-desktop_host_drain", "parallels-desktop_orchestrator_host"},
+		{"other host does not conflict", "https://orchestrator.example.com", "host-2", "parallels-desktop_host_drain", ""},
+		{"other orchestrator does not conflict", "https://other.example.com", "host-1", "parallels-desktop_host_drain", ""},
+		{"unknown host id is ignored", "https://orchestrator.example.com", "", "parallels-desktop_host_drain", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClaimHostMaintenance(tt.orchestrator, tt.hostId, tt.resourceType); got != tt.want {
+				t.Errorf("ClaimHostMaintenance() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
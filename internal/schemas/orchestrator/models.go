@@ -15,6 +15,8 @@ type OrchestratorRegistration struct {
 	Tags            []string                      `tfsdk:"tags"`
 	HostCredentials *authenticator.Authentication `tfsdk:"host_credentials"`
 	Orchestrator    *OrchestratorDetails          `tfsdk:"orchestrator"`
+	// ApiPrefix is the api prefix of the registered host, it comes from the host deployment
+	ApiPrefix string `tfsdk:"-"`
 }
 
 func (o OrchestratorRegistration) GetHost() string {
@@ -0,0 +1,58 @@
+package placement
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Placement struct {
+	HostId            types.String   `tfsdk:"host_id"`
+	RequiredTags      []types.String `tfsdk:"required_tags"`
+	PreferredTags     []types.String `tfsdk:"preferred_tags"`
+	AntiAffinityGroup types.String   `tfsdk:"anti_affinity_group"`
+	MinFreeCpuCount   types.Int64    `tfsdk:"min_free_cpu_count"`
+	MinFreeMemorySize types.Int64    `tfsdk:"min_free_memory_size"`
+	MinFreeDiskSize   types.Int64    `tfsdk:"min_free_disk_size"`
+}
+
+// IsEmpty returns true if the block is not set or none of the constraints are set
+func (p *Placement) IsEmpty() bool {
+	if p == nil {
+		return true
+	}
+
+	return p.HostId.ValueString() == "" &&
+		len(common.GetStrings(p.RequiredTags)) == 0 &&
+		len(common.GetStrings(p.PreferredTags)) == 0 &&
+		p.AntiAffinityGroup.ValueString() == "" &&
+		!p.hasMinFreeResources()
+}
+
+// GetRequest returns the placement sent with the create machine request, or nil if there are no constraints
+func (p *Placement) GetRequest() *apimodels.CreateVmPlacementRequest {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	request := apimodels.CreateVmPlacementRequest{
+		HostId:            p.HostId.ValueString(),
+		RequiredTags:      common.GetStrings(p.RequiredTags),
+		PreferredTags:     common.GetStrings(p.PreferredTags),
+		AntiAffinityGroup: p.AntiAffinityGroup.ValueString(),
+	}
+	if p.hasMinFreeResources() {
+		request.MinFreeResources = &apimodels.CreateVmPlacementResources{
+			LogicalCpuCount: p.MinFreeCpuCount.ValueInt64(),
+			MemorySize:      float64(p.MinFreeMemorySize.ValueInt64()),
+			DiskSize:        float64(p.MinFreeDiskSize.ValueInt64()),
+		}
+	}
+
+	return &request
+}
+
+func (p *Placement) hasMinFreeResources() bool {
+	return p.MinFreeCpuCount.ValueInt64() > 0 || p.MinFreeMemorySize.ValueInt64() > 0 || p.MinFreeDiskSize.ValueInt64() > 0
+}
@@ -0,0 +1,64 @@
+package placement
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	SchemaName  = "placement"
+	SchemaBlock = schema.SingleNestedBlock{
+		MarkdownDescription: "Placement block, only used when creating the machine through an orchestrator to choose the host it will run on. Changing it does not move machines that already exist",
+		Description:         "Placement block, only used when creating the machine through an orchestrator to choose the host it will run on. Changing it does not move machines that already exist",
+		Attributes: map[string]schema.Attribute{
+			"host_id": schema.StringAttribute{
+				MarkdownDescription: "Orchestrator host id the machine needs to be created on",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"required_tags": schema.ListAttribute{
+				MarkdownDescription: "Tags the host needs to have all of to run the machine",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"preferred_tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the hosts the orchestrator should prefer when more than one host can run the machine",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"anti_affinity_group": schema.StringAttribute{
+				MarkdownDescription: "Machines in the same anti affinity group are spread across different hosts",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"min_free_cpu_count": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of free logical cpus a host needs to have to run the machine",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_free_memory_size": schema.Int64Attribute{
+				MarkdownDescription: "Minimum free memory in megabytes a host needs to have to run the machine",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_free_disk_size": schema.Int64Attribute{
+				MarkdownDescription: "Minimum free disk in megabytes a host needs to have to run the machine",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,197 @@
+package placement
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Validate checks the orchestrator has at least one host able to run the machine with the placement
+// constraints, so we fail before asking the orchestrator to create the machine
+func Validate(ctx context.Context, hostConfig apiclient.HostConfig, placement *Placement, architecture string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if placement.IsEmpty() {
+		return diagnostics
+	}
+
+	if !hostConfig.IsOrchestrator {
+		diagnostics.AddAttributeError(path.Root(SchemaName), "Placement requires an orchestrator", "The placement block can only be used when creating the machine through an orchestrator")
+		return diagnostics
+	}
+
+	hosts, hostsDiag := apiclient.GetOrchestratorHosts(ctx, hostConfig)
+	if hostsDiag.HasError() {
+		diagnostics.Append(hostsDiag...)
+		return diagnostics
+	}
+
+	hostId := placement.HostId.ValueString()
+	if hostId != "" {
+		found := false
+		for _, host := range hosts {
+			if host.ID == hostId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diagnostics.AddAttributeError(path.Root(SchemaName).AtName("host_id"), "Host not found", "Could not find the host "+hostId+" in the orchestrator")
+			return diagnostics
+		}
+	}
+
+	candidates := make([]apimodels.OrchestratorHost, 0)
+	for _, host := range common.FilterOrchestratorHosts(hosts, architecture, "", common.GetStrings(placement.RequiredTags)) {
+		if !host.Enabled || host.Maintenance {
+			continue
+		}
+		if hostId != "" && host.ID != hostId {
+			continue
+		}
+		candidates = append(candidates, host)
+	}
+
+	if len(candidates) == 0 {
+		constraints := []string{"enabled and not in maintenance"}
+		if architecture != "" {
+			constraints = append(constraints, "architecture "+architecture)
+		}
+		if tags := common.GetStrings(placement.RequiredTags); len(tags) > 0 {
+			constraints = append(constraints, "tags "+strings.Join(tags, ", "))
+		}
+		if hostId != "" {
+			constraints = append(constraints, "id "+hostId)
+		}
+		diagnostics.AddAttributeError(path.Root(SchemaName), "No host matches the placement", "There is no host in the orchestrator that is "+strings.Join(constraints, ", with "))
+		return diagnostics
+	}
+
+	if !placement.hasMinFreeResources() {
+		return diagnostics
+	}
+
+	// the free resources are checked in each candidate host, the orchestrator totals add up the free
+	// resources of all the hosts and a machine cannot be split between them
+	unchecked := make([]string, 0)
+	for _, host := range candidates {
+		hostUsageConfig := hostConfig
+		hostUsageConfig.HostId = host.ID
+		usage, usageDiag := apiclient.GetSystemUsage(ctx, hostUsageConfig)
+		if usageDiag.HasError() || usage == nil {
+			unchecked = append(unchecked, host.ID)
+			continue
+		}
+		if placement.hasFreeResources(usage.TotalAvailable) {
+			return diagnostics
+		}
+	}
+
+	if len(unchecked) > 0 {
+		diagnostics.AddAttributeWarning(path.Root(SchemaName), "Could not check the free resources", fmt.Sprintf("Could not read the free resources of the hosts %s, the orchestrator will check them when creating the machine", strings.Join(unchecked, ", ")))
+		return diagnostics
+	}
+
+	diagnostics.AddAttributeError(path.Root(SchemaName), "Not enough free resources", "None of the hosts matching the placement has the minimum free resources required by the placement")
+	return diagnostics
+}
+
+// placedMachines keeps the anti affinity group machines created while the provider runs, older
+// orchestrators do not report the group of their machines
+var (
+	placedMachines      = []placedMachine{}
+	placedMachinesMutex sync.Mutex
+)
+
+type placedMachine struct {
+	orchestrator string
+	group        string
+	machineId    string
+	hostId       string
+}
+
+// VerifyPlacement warns when the orchestrator created the machine in a host that does not match the
+// placement, older orchestrators ignore the placement request
+func VerifyPlacement(ctx context.Context, hostConfig apiclient.HostConfig, placement *Placement, machineId string, hostId string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if placement.IsEmpty() || !hostConfig.IsOrchestrator || hostId == "" {
+		return diagnostics
+	}
+
+	if placement.HostId.ValueString() != "" && placement.HostId.ValueString() != hostId {
+		diagnostics.AddAttributeWarning(path.Root(SchemaName), "Placement not honored", fmt.Sprintf("The machine was created in the host %s instead of %s", hostId, placement.HostId.ValueString()))
+	}
+
+	if requiredTags := common.GetStrings(placement.RequiredTags); len(requiredTags) > 0 {
+		host, hostDiag := apiclient.GetOrchestratorHost(ctx, hostConfig, hostId)
+		if !hostDiag.HasError() && host != nil && len(common.FilterOrchestratorHosts([]apimodels.OrchestratorHost{*host}, "", "", requiredTags)) == 0 {
+			diagnostics.AddAttributeWarning(path.Root(SchemaName), "Placement not honored", fmt.Sprintf("The machine was created in the host %s that does not have the tags %s", hostId, strings.Join(requiredTags, ", ")))
+		}
+	}
+
+	if group := placement.AntiAffinityGroup.ValueString(); group != "" {
+		diagnostics.Append(verifyAntiAffinity(ctx, hostConfig, group, machineId, hostId)...)
+	}
+
+	return diagnostics
+}
+
+// verifyAntiAffinity warns when another machine of the anti affinity group runs in the same host, the
+// machines are read from the orchestrator and from the ones created while the provider runs
+func verifyAntiAffinity(ctx context.Context, hostConfig apiclient.HostConfig, group string, machineId string, hostId string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	orchestrator := strings.ToLower(strings.TrimSuffix(hostConfig.Host, "/"))
+
+	placedMachinesMutex.Lock()
+	machines := append([]placedMachine{}, placedMachines...)
+	placedMachines = append(placedMachines, placedMachine{orchestrator: orchestrator, group: group, machineId: machineId, hostId: hostId})
+	placedMachinesMutex.Unlock()
+
+	if vms, vmsDiag := apiclient.GetVms(ctx, hostConfig, "", ""); !vmsDiag.HasError() {
+		for _, vm := range vms {
+			if vm.AntiAffinityGroup != "" {
+				machines = append(machines, placedMachine{orchestrator: orchestrator, group: vm.AntiAffinityGroup, machineId: vm.ID, hostId: vm.HostId})
+			}
+		}
+	}
+
+	if sharing := getSameHostMachines(machines, orchestrator, group, machineId, hostId); len(sharing) > 0 {
+		diagnostics.AddAttributeWarning(path.Root(SchemaName).AtName("anti_affinity_group"), "Placement not honored", fmt.Sprintf("The machine was created in the host %s that already runs the machines %s of the anti affinity group %s", hostId, strings.Join(sharing, ", "), group))
+	}
+
+	return diagnostics
+}
+
+// getSameHostMachines returns the other machines of the anti affinity group running in the host
+func getSameHostMachines(machines []placedMachine, orchestrator string, group string, machineId string, hostId string) []string {
+	result := make([]string, 0)
+	for _, machine := range machines {
+		if machine.orchestrator != orchestrator || !strings.EqualFold(machine.group, group) {
+			continue
+		}
+		if machine.machineId == machineId || machine.hostId != hostId || slices.Contains(result, machine.machineId) {
+			continue
+		}
+		result = append(result, machine.machineId)
+	}
+
+	return result
+}
+
+func (p *Placement) hasFreeResources(available *apimodels.SystemUsageItem) bool {
+	if available == nil {
+		return false
+	}
+
+	return available.LogicalCpuCount >= p.MinFreeCpuCount.ValueInt64() &&
+		available.MemorySize >= float64(p.MinFreeMemorySize.ValueInt64()) &&
+		available.DiskSize >= float64(p.MinFreeDiskSize.ValueInt64())
+}
@@ -0,0 +1,227 @@
+package placement
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTestOrchestrator returns the config of a fake orchestrator with the given hosts and the free
+// resources of each host, hosts without free resources fail to report their usage
+func newTestOrchestrator(t *testing.T, hosts []apimodels.OrchestratorHost, available map[string]*apimodels.SystemUsageItem) apiclient.HostConfig {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/orchestrator/hosts":
+			_ = json.NewEncoder(w).Encode(hosts)
+		case strings.HasPrefix(r.URL.Path, "/api/v1/orchestrator/hosts/") && strings.HasSuffix(r.URL.Path, "/hardware"):
+			hostId := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/orchestrator/hosts/"), "/hardware")
+			usage, ok := available[hostId]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(apimodels.SystemUsageResponse{TotalAvailable: usage})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return apiclient.HostConfig{
+		Host:           server.URL,
+		IsOrchestrator: true,
+		Authorization: &authenticator.Authentication{
+			ApiKey: types.StringValue("test"),
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	hosts := []apimodels.OrchestratorHost{
+		{ID: "small", Enabled: true, Architecture: "arm64", Tags: []string{"ci"}},
+		{ID: "large", Enabled: true, Architecture: "arm64", Tags: []string{"build"}},
+		{ID: "intel", Enabled: true, Architecture: "x86_64", Tags: []string{"ci"}},
+		{ID: "disabled", Enabled: false, Architecture: "arm64", Tags: []string{"ci"}},
+		{ID: "maintenance", Enabled: true, Maintenance: true, Architecture: "arm64", Tags: []string{"ci"}},
+	}
+	available := map[string]*apimodels.SystemUsageItem{
+		"small":       {LogicalCpuCount: 2, MemorySize: 4096, DiskSize: 100000},
+		"large":       {LogicalCpuCount: 16, MemorySize: 65536, DiskSize: 1000000},
+		"intel":       {LogicalCpuCount: 16, MemorySize: 65536, DiskSize: 1000000},
+		"disabled":    {LogicalCpuCount: 16, MemorySize: 65536, DiskSize: 1000000},
+		"maintenance": {LogicalCpuCount: 16, MemorySize: 65536, DiskSize: 1000000},
+	}
+
+	tests := []struct {
+		name         string
+		placement    *Placement
+		architecture string
+		available    map[string]*apimodels.SystemUsageItem
+		wantErr      bool
+		wantWarning  bool
+	}{
+		{
+			name:      "empty placement",
+			placement: nil,
+		},
+		{
+			name:      "host found",
+			placement: &Placement{HostId: types.StringValue("small")},
+		},
+		{
+			name:      "host not found",
+			placement: &Placement{HostId: types.StringValue("missing")},
+			wantErr:   true,
+		},
+		{
+			name:      "required tags match",
+			placement: &Placement{RequiredTags: []types.String{types.StringValue("CI")}},
+		},
+		{
+			name:         "required tags on a host of the machine architecture",
+			placement:    &Placement{RequiredTags: []types.String{types.StringValue("ci")}},
+			architecture: "x86_64",
+		},
+		{
+			name:      "required tags not in any host",
+			placement: &Placement{RequiredTags: []types.String{types.StringValue("gpu")}},
+			wantErr:   true,
+		},
+		{
+			name:      "host in maintenance",
+			placement: &Placement{HostId: types.StringValue("maintenance")},
+			wantErr:   true,
+		},
+		{
+			name:      "one candidate has the free resources",
+			placement: &Placement{MinFreeCpuCount: types.Int64Value(8), MinFreeMemorySize: types.Int64Value(32768)},
+		},
+		{
+			name: "the free resources are only in a host without the required tags",
+			placement: &Placement{
+				RequiredTags:    []types.String{types.StringValue("ci")},
+				MinFreeCpuCount: types.Int64Value(8),
+			},
+			architecture: "arm64",
+			wantErr:      true,
+		},
+		{
+			name: "the selected host does not have the free resources",
+			placement: &Placement{
+				HostId:          types.StringValue("small"),
+				MinFreeCpuCount: types.Int64Value(8),
+			},
+			wantErr: true,
+		},
+		{
+			name: "the free resources of the candidates add up but none has them",
+			placement: &Placement{
+				RequiredTags:      []types.String{types.StringValue("ci")},
+				MinFreeMemorySize: types.Int64Value(6144),
+			},
+			architecture: "arm64",
+			available: map[string]*apimodels.SystemUsageItem{
+				"small":       {LogicalCpuCount: 2, MemorySize: 4096, DiskSize: 100000},
+				"disabled":    {LogicalCpuCount: 2, MemorySize: 4096, DiskSize: 100000},
+				"maintenance": {LogicalCpuCount: 2, MemorySize: 4096, DiskSize: 100000},
+			},
+			wantErr: true,
+		},
+		{
+			name: "candidate usage cannot be read",
+			placement: &Placement{
+				HostId:          types.StringValue("large"),
+				MinFreeCpuCount: types.Int64Value(8),
+			},
+			available:   map[string]*apimodels.SystemUsageItem{},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostsAvailable := available
+			if tt.available != nil {
+				hostsAvailable = tt.available
+			}
+			config := newTestOrchestrator(t, hosts, hostsAvailable)
+
+			diagnostics := Validate(context.Background(), config, tt.placement, tt.architecture)
+			if diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("has error = %v, want %v: %v", diagnostics.HasError(), tt.wantErr, diagnostics)
+			}
+			if hasWarning := diagnostics.WarningsCount() > 0; hasWarning != tt.wantWarning {
+				t.Fatalf("has warning = %v, want %v: %v", hasWarning, tt.wantWarning, diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateRequiresOrchestrator(t *testing.T) {
+	config := apiclient.HostConfig{Host: "https://example.com"}
+	diagnostics := Validate(context.Background(), config, &Placement{HostId: types.StringValue("host")}, "")
+	if !diagnostics.HasError() {
+		t.Fatal("expected an error when the placement is used without an orchestrator")
+	}
+}
+
+func TestGetSameHostMachines(t *testing.T) {
+	machines := []placedMachine{
+		{orchestrator: "https://orchestrator", group: "agents", machineId: "vm-1", hostId: "host-a"},
+		{orchestrator: "https://orchestrator", group: "Agents", machineId: "vm-2", hostId: "host-a"},
+		{orchestrator: "https://orchestrator", group: "agents", machineId: "vm-2", hostId: "host-a"},
+		{orchestrator: "https://orchestrator", group: "agents", machineId: "vm-3", hostId: "host-b"},
+		{orchestrator: "https://orchestrator", group: "web", machineId: "vm-4", hostId: "host-a"},
+		{orchestrator: "https://other", group: "agents", machineId: "vm-5", hostId: "host-a"},
+	}
+
+	tests := []struct {
+		name      string
+		group     string
+		machineId string
+		hostId    string
+		want      []string
+	}{
+		{
+			name:      "other machines of the group in the host",
+			group:     "agents",
+			machineId: "vm-1",
+			hostId:    "host-a",
+			want:      []string{"vm-2"},
+		},
+		{
+			name:      "only machine of the group in the host",
+			group:     "agents",
+			machineId: "vm-3",
+			hostId:    "host-b",
+			want:      []string{},
+		},
+		{
+			name:      "group without other machines",
+			group:     "db",
+			machineId: "vm-6",
+			hostId:    "host-a",
+			want:      []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getSameHostMachines(machines, "https://orchestrator", tt.group, tt.machineId, tt.hostId)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("machines = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package reverseproxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// plannedResources keeps the reverse proxy hosts planned by every resource while the provider runs,
+// terraform plans all the resources in the same provider process so two resources using the same
+// listener are detected before applying
+var (
+	plannedResources      = []plannedResource{}
+	plannedResourcesMutex sync.Mutex
+)
+
+// plannedResource is a resource planning reverse proxy hosts, the owner is the id of the resource
+// in the state so a resource planned again replaces its previous entry, new resources do not have one
+type plannedResource struct {
+	apiHost string
+	owner   string
+	planned []ReverseProxyHost
+	current []ReverseProxyHost
+}
+
+// CheckConflicts validates the planned reverse proxy hosts against each other, against the ones
+// planned by other resources and against the reverse proxy hosts already configured in the host,
+// hosts in the current state belong to the resource and are not conflicts. The owner is the id of
+// the resource in the state, empty for new resources. The hostPath returns the attribute path of
+// each planned host so the diagnostics point to the conflicting attribute
+func CheckConflicts(ctx context.Context, config apiclient.HostConfig, owner string, plannedHosts []ReverseProxyHost, currentHosts []ReverseProxyHost, hostPath func(index int) path.Path) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if len(plannedHosts) == 0 {
+		return diagnostics
+	}
+
+	for i, host := range plannedHosts {
+		diagnostics.Append(checkRoutesConflicts(host, hostPath(i))...)
+
+		for j := 0; j < i; j++ {
+			if listenersConflict(host.Host.ValueString(), host.Port, plannedHosts[j].Host.ValueString(), plannedHosts[j].Port) {
+				diagnostics.AddAttributeError(
+					hostPath(i).AtName("port"),
+					"Duplicated reverse proxy listener",
+					fmt.Sprintf("The reverse proxy host %s listens on the same address and port as the reverse proxy host %s declared in the same resource", host.GetHost(), plannedHosts[j].GetHost()),
+				)
+			}
+		}
+	}
+
+	// the orchestrator needs to know which host runs the reverse proxy
+	if config.IsOrchestrator && config.HostId == "" {
+		return diagnostics
+	}
+
+	apiHost := strings.ToLower(strings.TrimSuffix(config.Host, "/")) + "/" + config.HostId
+	otherResources := recordPlannedResource(plannedResource{apiHost: apiHost, owner: owner, planned: plannedHosts, current: currentHosts})
+	diagnostics.Append(checkPlannedConflicts(plannedHosts, otherResources, hostPath)...)
+
+	usage, usageDiag := apiclient.GetSystemUsage(ctx, config)
+	if usageDiag.HasError() {
+		diagnostics.AddWarning("Could not check the reverse proxy conflicts", "The reverse proxy configuration of the host could not be read, conflicts with other reverse proxy hosts will only be detected when applying")
+		return diagnostics
+	}
+	if usage == nil || usage.ReverseProxy == nil {
+		return diagnostics
+	}
+
+	for i, host := range plannedHosts {
+		for _, existing := range usage.ReverseProxy.Hosts {
+			if isOwnedHost(existing, currentHosts) {
+				continue
+			}
+			if !listenersConflict(host.Host.ValueString(), host.Port, existing.Host, existing.Port) {
+				continue
+			}
+
+			// the listener can be released by the other resource in the same apply
+			if isOwnedByResources(existing, otherResources) {
+				diagnostics.AddAttributeWarning(
+					hostPath(i).AtName("port"),
+					"Reverse proxy listener used by another resource",
+					fmt.Sprintf("The reverse proxy host %s uses the listener of the reverse proxy host %s (id %s) managed by another resource, the apply fails unless that resource releases it first", host.GetHost(), getApiHost(existing), existing.ID),
+				)
+				continue
+			}
+
+			detail := fmt.Sprintf("The reverse proxy host %s conflicts with the reverse proxy host %s (id %s) that is already configured in the host and is not managed by this resource", host.GetHost(), getApiHost(existing), existing.ID)
+			if overlapping := getOverlappingRoutes(host, existing); len(overlapping) > 0 {
+				detail += ", both route " + strings.Join(overlapping, ", ")
+			}
+			diagnostics.AddAttributeError(hostPath(i).AtName("port"), "Reverse proxy listener already in use", detail)
+		}
+	}
+
+	return diagnostics
+}
+
+// HostPath returns the attribute path of a reverse proxy host nested in a resource
+func HostPath(index int) path.Path {
+	return path.Root(SchemaName).AtListIndex(index)
+}
+
+// recordPlannedResource records the reverse proxy hosts planned by a resource and returns the other
+// resources planned for the same host. A resource in the state replaces its previous entry, a new
+// resource planning the same hosts as another new one is the same resource being planned again
+func recordPlannedResource(resource plannedResource) []plannedResource {
+	plannedResourcesMutex.Lock()
+	defer plannedResourcesMutex.Unlock()
+
+	others := make([]plannedResource, 0)
+	records := make([]plannedResource, 0, len(plannedResources)+1)
+	alreadyPlanned := false
+	for _, planned := range plannedResources {
+		if resource.owner != "" && planned.owner == resource.owner {
+			continue
+		}
+		records = append(records, planned)
+
+		if planned.apiHost != resource.apiHost {
+			continue
+		}
+		if resource.owner == "" && planned.owner == "" && !hostsDiff(resource.planned, planned.planned) {
+			alreadyPlanned = true
+			continue
+		}
+		others = append(others, planned)
+	}
+
+	if !alreadyPlanned {
+		records = append(records, plannedResource{
+			apiHost: resource.apiHost,
+			owner:   resource.owner,
+			planned: copyHosts(resource.planned),
+			current: copyHosts(resource.current),
+		})
+	}
+	plannedResources = records
+
+	return others
+}
+
+// checkPlannedConflicts reports the planned hosts using a listener planned by another resource for
+// the same host
+func checkPlannedConflicts(plannedHosts []ReverseProxyHost, otherResources []plannedResource, hostPath func(index int) path.Path) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	for i, host := range plannedHosts {
+		for _, resource := range otherResources {
+			for _, planned := range resource.planned {
+				if !listenersConflict(host.Host.ValueString(), host.Port, planned.Host.ValueString(), planned.Port) {
+					continue
+				}
+
+				detail := fmt.Sprintf("The reverse proxy host %s conflicts with the reverse proxy host %s planned by another resource for the same host", host.GetHost(), planned.GetHost())
+				if overlapping := getOverlappingRoutes(host, mapReverseProxyToApiModel(planned)); len(overlapping) > 0 {
+					detail += ", both route " + strings.Join(overlapping, ", ")
+				}
+				diagnostics.AddAttributeError(hostPath(i).AtName("port"), "Reverse proxy listener planned twice", detail)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// isOwnedByResources returns true if the reverse proxy host is in the state of one of the resources
+func isOwnedByResources(existing apimodels.ReverseProxyHost, resources []plannedResource) bool {
+	for _, resource := range resources {
+		if isOwnedHost(existing, resource.current) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyHosts(hosts []ReverseProxyHost) []ReverseProxyHost {
+	result := make([]ReverseProxyHost, 0, len(hosts))
+	for i := range hosts {
+		result = append(result, hosts[i].Copy())
+	}
+
+	return result
+}
+
+func hostsDiff(hosts []ReverseProxyHost, otherHosts []ReverseProxyHost) bool {
+	if len(hosts) != len(otherHosts) {
+		return true
+	}
+	for i := range hosts {
+		if hosts[i].Diff(&otherHosts[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkRoutesConflicts reports the http routes of a host that match the same path or pattern
+func checkRoutesConflicts(host ReverseProxyHost, hostPath path.Path) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	seenPaths := map[string]int{}
+	seenPatterns := map[string]int{}
+	for i, route := range host.HttpRoute {
+		if route == nil {
+			continue
+		}
+
+		if route.Path != "" {
+			routePath := normalizeRoutePath(route.Path)
+			if previous, ok := seenPaths[routePath]; ok {
+				diagnostics.AddAttributeError(
+					hostPath.AtName("http_routes").AtListIndex(i).AtName("path"),
+					"Overlapping reverse proxy routes",
+					fmt.Sprintf("The path %s is already routed by the http route %d of the reverse proxy host %s", route.Path, previous, host.GetHost()),
+				)
+			} else {
+				seenPaths[routePath] = i
+			}
+		}
+
+		if route.Pattern != "" {
+			routePattern := normalizeRoutePattern(route.Pattern)
+			if previous, ok := seenPatterns[routePattern]; ok {
+				diagnostics.AddAttributeError(
+					hostPath.AtName("http_routes").AtListIndex(i).AtName("pattern"),
+					"Overlapping reverse proxy routes",
+					fmt.Sprintf("The pattern %s is already routed by the http route %d of the reverse proxy host %s", route.Pattern, previous, host.GetHost()),
+				)
+			} else {
+				seenPatterns[routePattern] = i
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// listenersConflict returns true if both listeners use the same port and address, listening
+// on all the addresses conflicts with any other address
+func listenersConflict(hostA, portA, hostB, portB string) bool {
+	if portA == "" || portB == "" || portA != portB {
+		return false
+	}
+
+	hostA = normalizeListenerHost(hostA)
+	hostB = normalizeListenerHost(hostB)
+
+	return hostA == hostB || hostA == allAddress || hostB == allAddress
+}
+
+func isOwnedHost(existing apimodels.ReverseProxyHost, currentHosts []ReverseProxyHost) bool {
+	for _, current := range currentHosts {
+		if existing.ID != "" && common.GetString(current.ID) == existing.ID {
+			return true
+		}
+		if current.Port == existing.Port && normalizeListenerHost(current.Host.ValueString()) == normalizeListenerHost(existing.Host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getOverlappingRoutes(host ReverseProxyHost, existing apimodels.ReverseProxyHost) []string {
+	result := make([]string, 0)
+	for _, route := range host.HttpRoute {
+		if route == nil {
+			continue
+		}
+		for _, existingRoute := range existing.HttpRoutes {
+			if existingRoute == nil {
+				continue
+			}
+			if route.Path != "" && existingRoute.Path != "" && normalizeRoutePath(route.Path) == normalizeRoutePath(existingRoute.Path) {
+				result = append(result, "the path "+route.Path)
+				break
+			}
+			if route.Pattern != "" && existingRoute.Pattern != "" && normalizeRoutePattern(route.Pattern) == normalizeRoutePattern(existingRoute.Pattern) {
+				result = append(result, "the pattern "+route.Pattern)
+				break
+			}
+		}
+	}
+	if host.TcpRoute != nil && existing.TcpRoute != nil {
+		result = append(result, "tcp traffic")
+	}
+
+	return result
+}
+
+func getApiHost(host apimodels.ReverseProxyHost) string {
+	return normalizeListenerHost(host.Host) + ":" + host.Port
+}
+
+func normalizeListenerHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if host == "" {
+		return allAddress
+	}
+
+	return host
+}
+
+func normalizeRoutePath(routePath string) string {
+	routePath = strings.TrimSuffix(strings.TrimSpace(routePath), "/")
+	if !strings.HasPrefix(routePath, "/") {
+		routePath = "/" + routePath
+	}
+
+	return routePath
+}
+
+// normalizeRoutePattern returns the pattern in lower case and without the trailing slash so patterns
+// only differing on those are reported as the same route
+func normalizeRoutePattern(pattern string) string {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if len(pattern) > 1 {
+		pattern = strings.TrimSuffix(pattern, "/")
+	}
+
+	return pattern
+}
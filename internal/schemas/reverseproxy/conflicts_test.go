@@ -0,0 +1,71 @@
+package reverseproxy
+
+import (
+	"testing"
+
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTestHost(id, port string) ReverseProxyHost {
+	return ReverseProxyHost{
+		ID:   types.StringValue(id),
+		Host: types.StringValue("0.0.0.0"),
+		Port: port,
+	}
+}
+
+func TestPlannedResources(t *testing.T) {
+	plannedResources = []plannedResource{}
+	t.Cleanup(func() { plannedResources = []plannedResource{} })
+
+	hostPath := func(int) path.Path { return path.Empty() }
+	apiHost := "https://host/"
+
+	// resource a moves its listener from port 80 to port 8080
+	a := plannedResource{apiHost: apiHost, owner: "a", planned: []ReverseProxyHost{newTestHost("a", "8080")}, current: []ReverseProxyHost{newTestHost("a", "80")}}
+	if others := recordPlannedResource(a); len(others) != 0 {
+		t.Fatalf("other resources = %d, want 0", len(others))
+	}
+
+	// planning resource a again with a different host does not conflict with its own entry
+	a.planned = []ReverseProxyHost{newTestHost("a", "8081")}
+	if others := recordPlannedResource(a); len(others) != 0 {
+		t.Fatalf("other resources = %d, want 0 when the same resource is planned again", len(others))
+	}
+	if len(plannedResources) != 1 {
+		t.Fatalf("planned resources = %d, want 1", len(plannedResources))
+	}
+
+	// resource b is new and takes port 80 released by resource a
+	b := plannedResource{apiHost: apiHost, planned: []ReverseProxyHost{newTestHost("", "80")}}
+	others := recordPlannedResource(b)
+	if diagnostics := checkPlannedConflicts(b.planned, others, hostPath); diagnostics.HasError() {
+		t.Fatalf("unexpected conflict: %v", diagnostics)
+	}
+	if !isOwnedByResources(apimodels.ReverseProxyHost{ID: "a", Host: "0.0.0.0", Port: "80"}, others) {
+		t.Error("the listener in the state of resource a is owned by another resource in the run")
+	}
+	if isOwnedByResources(apimodels.ReverseProxyHost{ID: "unmanaged", Host: "0.0.0.0", Port: "443"}, others) {
+		t.Error("a listener not in any state is not owned by another resource in the run")
+	}
+
+	// planning the new resource b again is not a conflict with itself
+	if others := recordPlannedResource(b); len(others) != 1 {
+		t.Fatalf("other resources = %d, want 1 when the new resource is planned again", len(others))
+	}
+
+	// resource c is new and uses the port planned by resource a
+	c := plannedResource{apiHost: apiHost, planned: []ReverseProxyHost{newTestHost("", "8081")}}
+	if diagnostics := checkPlannedConflicts(c.planned, recordPlannedResource(c), hostPath); !diagnostics.HasError() {
+		t.Error("expected the listener planned by resource a to conflict")
+	}
+
+	// the same port in another host is not a conflict
+	d := plannedResource{apiHost: "https://other/", planned: []ReverseProxyHost{newTestHost("", "8081")}}
+	if diagnostics := checkPlannedConflicts(d.planned, recordPlannedResource(d), hostPath); diagnostics.HasError() {
+		t.Errorf("unexpected conflict in another host: %v", diagnostics)
+	}
+}
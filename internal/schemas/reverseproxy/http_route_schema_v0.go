@@ -12,7 +12,12 @@ var HttpRouteSchemaBlockV0 = schema.ListNestedBlock{
 	MarkdownDescription: "Parallels Desktop DevOps Reverse Proxy Http Route CORS configuration",
 	Description:         "Parallels Desktop DevOps Reverse Proxy Http Route CORS configuration",
 	NestedObject: schema.NestedBlockObject{
+		Blocks: map[string]schema.Block{
+			"targets":      TargetsSchemaBlockV0,
+			"health_check": HealthCheckSchemaBlockV0,
+		},
 		Attributes: map[string]schema.Attribute{
+			"load_balancing": LoadBalancingAttributeV0,
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Reverse proxy HTTP Route path",
 				Description:         "Reverse proxy HTTP Route path",
@@ -104,9 +104,12 @@ func (o *ReverseProxyHost) Diff(other *ReverseProxyHost) bool {
 }
 
 type ReverseProxyHostTcpRoute struct {
-	TargetPort basetypes.StringValue `tfsdk:"target_port"`
-	TargetHost basetypes.StringValue `tfsdk:"target_host"`
-	TargetVmId basetypes.StringValue `tfsdk:"target_vm_id"`
+	TargetPort    basetypes.StringValue    `tfsdk:"target_port"`
+	TargetHost    basetypes.StringValue    `tfsdk:"target_host"`
+	TargetVmId    basetypes.StringValue    `tfsdk:"target_vm_id"`
+	Targets       []*ReverseProxyTarget    `tfsdk:"targets"`
+	LoadBalancing basetypes.StringValue    `tfsdk:"load_balancing"`
+	HealthCheck   *ReverseProxyHealthCheck `tfsdk:"health_check"`
 }
 
 func (o *ReverseProxyHostTcpRoute) GetHost() string {
@@ -137,6 +140,12 @@ func (o *ReverseProxyHostTcpRoute) Copy() ReverseProxyHostTcpRoute {
 	result.TargetPort = o.TargetPort
 	result.TargetHost = o.TargetHost
 	result.TargetVmId = o.TargetVmId
+	result.Targets = copyTargets(o.Targets)
+	result.LoadBalancing = o.LoadBalancing
+	if o.HealthCheck != nil {
+		healthCheck := *o.HealthCheck
+		result.HealthCheck = &healthCheck
+	}
 
 	return result
 }
@@ -160,7 +169,13 @@ func (o *ReverseProxyHostTcpRoute) Diff(other *ReverseProxyHostTcpRoute) bool {
 	if common.GetString(o.TargetVmId) != common.GetString(other.TargetVmId) {
 		return true
 	}
-	return false
+	if targetsDiff(o.Targets, other.Targets) {
+		return true
+	}
+	if common.GetString(o.LoadBalancing) != common.GetString(other.LoadBalancing) {
+		return true
+	}
+	return o.HealthCheck.Diff(other.HealthCheck)
 }
 
 type ReverseProxyCors struct {
@@ -255,14 +270,17 @@ func (o *ReverseProxyTls) Diff(other *ReverseProxyTls) bool {
 }
 
 type ReverseProxyHttpRoute struct {
-	TargetPort      basetypes.StringValue `tfsdk:"target_port"`
-	TargetHost      basetypes.StringValue `tfsdk:"target_host"`
-	TargetVmId      basetypes.StringValue `tfsdk:"target_vm_id"`
-	Path            string                `tfsdk:"path"`
-	Pattern         string                `tfsdk:"pattern"`
-	Schema          string                `tfsdk:"schema"`
-	RequestHeaders  map[string]string     `tfsdk:"request_headers"`
-	ResponseHeaders map[string]string     `tfsdk:"response_headers"`
+	TargetPort      basetypes.StringValue    `tfsdk:"target_port"`
+	TargetHost      basetypes.StringValue    `tfsdk:"target_host"`
+	TargetVmId      basetypes.StringValue    `tfsdk:"target_vm_id"`
+	Path            string                   `tfsdk:"path"`
+	Pattern         string                   `tfsdk:"pattern"`
+	Schema          string                   `tfsdk:"schema"`
+	RequestHeaders  map[string]string        `tfsdk:"request_headers"`
+	ResponseHeaders map[string]string        `tfsdk:"response_headers"`
+	Targets         []*ReverseProxyTarget    `tfsdk:"targets"`
+	LoadBalancing   basetypes.StringValue    `tfsdk:"load_balancing"`
+	HealthCheck     *ReverseProxyHealthCheck `tfsdk:"health_check"`
 }
 
 func (o *ReverseProxyHttpRoute) GetHost() string {
@@ -297,6 +315,12 @@ func (o *ReverseProxyHttpRoute) Copy() ReverseProxyHttpRoute {
 	result.Schema = o.Schema
 	result.RequestHeaders = o.RequestHeaders
 	result.ResponseHeaders = o.ResponseHeaders
+	result.Targets = copyTargets(o.Targets)
+	result.LoadBalancing = o.LoadBalancing
+	if o.HealthCheck != nil {
+		healthCheck := *o.HealthCheck
+		result.HealthCheck = &healthCheck
+	}
 
 	return result
 }
@@ -345,6 +369,108 @@ func (o *ReverseProxyHttpRoute) Diff(other *ReverseProxyHttpRoute) bool {
 			return true
 		}
 	}
+	if targetsDiff(o.Targets, other.Targets) {
+		return true
+	}
+	if common.GetString(o.LoadBalancing) != common.GetString(other.LoadBalancing) {
+		return true
+	}
+	return o.HealthCheck.Diff(other.HealthCheck)
+}
+
+// ReverseProxyTarget is one of the targets a route balances the traffic between
+type ReverseProxyTarget struct {
+	VmId   basetypes.StringValue `tfsdk:"vm_id"`
+	Host   basetypes.StringValue `tfsdk:"host"`
+	Port   basetypes.StringValue `tfsdk:"port"`
+	Weight basetypes.Int64Value  `tfsdk:"weight"`
+	Drain  basetypes.BoolValue   `tfsdk:"drain"`
+}
+
+func (o *ReverseProxyTarget) Diff(other *ReverseProxyTarget) bool {
+	if o == nil && other == nil {
+		return false
+	}
+	if o == nil || other == nil {
+		return true
+	}
+	if common.GetString(o.VmId) != common.GetString(other.VmId) {
+		return true
+	}
+	if common.GetString(o.Host) != common.GetString(other.Host) {
+		return true
+	}
+	if common.GetString(o.Port) != common.GetString(other.Port) {
+		return true
+	}
+	if o.Weight.ValueInt64() != other.Weight.ValueInt64() {
+		return true
+	}
+	return o.Drain.ValueBool() != other.Drain.ValueBool()
+}
+
+// ReverseProxyHealthCheck is the active health check of the route targets
+type ReverseProxyHealthCheck struct {
+	Type               basetypes.StringValue `tfsdk:"type"`
+	Path               basetypes.StringValue `tfsdk:"path"`
+	ExpectedStatus     basetypes.Int64Value  `tfsdk:"expected_status"`
+	Interval           basetypes.Int64Value  `tfsdk:"interval"`
+	Timeout            basetypes.Int64Value  `tfsdk:"timeout"`
+	HealthyThreshold   basetypes.Int64Value  `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold basetypes.Int64Value  `tfsdk:"unhealthy_threshold"`
+}
+
+func (o *ReverseProxyHealthCheck) Diff(other *ReverseProxyHealthCheck) bool {
+	if o == nil && other == nil {
+		return false
+	}
+	if o == nil || other == nil {
+		return true
+	}
+	if common.GetString(o.Type) != common.GetString(other.Type) {
+		return true
+	}
+	if common.GetString(o.Path) != common.GetString(other.Path) {
+		return true
+	}
+	if o.ExpectedStatus.ValueInt64() != other.ExpectedStatus.ValueInt64() {
+		return true
+	}
+	if o.Interval.ValueInt64() != other.Interval.ValueInt64() {
+		return true
+	}
+	if o.Timeout.ValueInt64() != other.Timeout.ValueInt64() {
+		return true
+	}
+	if o.HealthyThreshold.ValueInt64() != other.HealthyThreshold.ValueInt64() {
+		return true
+	}
+	return o.UnhealthyThreshold.ValueInt64() != other.UnhealthyThreshold.ValueInt64()
+}
+
+func copyTargets(targets []*ReverseProxyTarget) []*ReverseProxyTarget {
+	if targets == nil {
+		return nil
+	}
+	result := make([]*ReverseProxyTarget, len(targets))
+	for i, v := range targets {
+		if v != nil {
+			target := *v
+			result[i] = &target
+		}
+	}
+	return result
+}
+
+func targetsDiff(a, b []*ReverseProxyTarget) bool {
+	if len(a) != len(b) {
+		return true
+	}
+	for i, v := range a {
+		if v.Diff(b[i]) {
+			return true
+		}
+	}
 	return false
 }
 
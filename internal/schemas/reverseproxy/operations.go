@@ -248,7 +248,10 @@ func mapReverseProxyToApiModel(v ReverseProxyHost) apimodels.ReverseProxyHost {
 		if common.GetString(v.TcpRoute.TargetVmId) != "" {
 			requestHost.TcpRoute.TargetVmId = common.GetString(v.TcpRoute.TargetVmId)
 		}
-		if common.GetString(v.TcpRoute.TargetHost) == "" && common.GetString(v.TcpRoute.TargetVmId) == "" {
+		requestHost.TcpRoute.Targets = mapTargetsToApiModel(v.TcpRoute.Targets)
+		requestHost.TcpRoute.LoadBalancing = common.GetString(v.TcpRoute.LoadBalancing)
+		requestHost.TcpRoute.HealthCheck = mapHealthCheckToApiModel(v.TcpRoute.HealthCheck)
+		if common.GetString(v.TcpRoute.TargetHost) == "" && common.GetString(v.TcpRoute.TargetVmId) == "" && len(v.TcpRoute.Targets) == 0 {
 			requestHost.TcpRoute.TargetHost = allAddress
 		}
 	}
@@ -265,8 +268,11 @@ func mapReverseProxyToApiModel(v ReverseProxyHost) apimodels.ReverseProxyHost {
 				Pattern:         route.Pattern,
 				RequestHeaders:  route.RequestHeaders,
 				ResponseHeaders: route.ResponseHeaders,
+				Targets:         mapTargetsToApiModel(route.Targets),
+				LoadBalancing:   common.GetString(route.LoadBalancing),
+				HealthCheck:     mapHealthCheckToApiModel(route.HealthCheck),
 			}
-			if common.GetString(route.TargetHost) == "" && common.GetString(route.TargetVmId) == "" {
+			if common.GetString(route.TargetHost) == "" && common.GetString(route.TargetVmId) == "" && len(route.Targets) == 0 {
 				httpRoute.TargetHost = allAddress
 			}
 
@@ -277,6 +283,114 @@ func mapReverseProxyToApiModel(v ReverseProxyHost) apimodels.ReverseProxyHost {
 	return requestHost
 }
 
+func mapTargetsToApiModel(targets []*ReverseProxyTarget) []*apimodels.ReverseProxyTarget {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	result := make([]*apimodels.ReverseProxyTarget, 0, len(targets))
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		result = append(result, &apimodels.ReverseProxyTarget{
+			VmId:   common.GetString(target.VmId),
+			Host:   common.GetString(target.Host),
+			Port:   common.GetString(target.Port),
+			Weight: target.Weight.ValueInt64(),
+			Drain:  target.Drain.ValueBool(),
+		})
+	}
+
+	return result
+}
+
+func mapHealthCheckToApiModel(healthCheck *ReverseProxyHealthCheck) *apimodels.ReverseProxyHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+
+	return &apimodels.ReverseProxyHealthCheck{
+		Type:               common.GetString(healthCheck.Type),
+		Path:               common.GetString(healthCheck.Path),
+		ExpectedStatus:     healthCheck.ExpectedStatus.ValueInt64(),
+		Interval:           healthCheck.Interval.ValueInt64(),
+		Timeout:            healthCheck.Timeout.ValueInt64(),
+		HealthyThreshold:   healthCheck.HealthyThreshold.ValueInt64(),
+		UnhealthyThreshold: healthCheck.UnhealthyThreshold.ValueInt64(),
+	}
+}
+
+// MapTargetsFromApiModel maps the route targets returned by the api, values the api omits because
+// they are empty are only kept if they were set in the prior targets
+func MapTargetsFromApiModel(targets []*apimodels.ReverseProxyTarget, prior []*ReverseProxyTarget) []*ReverseProxyTarget {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	result := make([]*ReverseProxyTarget, 0, len(targets))
+	for i, target := range targets {
+		if target == nil {
+			continue
+		}
+		priorTarget := &ReverseProxyTarget{}
+		if i < len(prior) && prior[i] != nil {
+			priorTarget = prior[i]
+		}
+		result = append(result, &ReverseProxyTarget{
+			VmId:   readString(target.VmId),
+			Host:   readString(target.Host),
+			Port:   readString(target.Port),
+			Weight: readInt64(target.Weight, priorTarget.Weight),
+			Drain:  readBool(target.Drain, priorTarget.Drain),
+		})
+	}
+
+	return result
+}
+
+// MapHealthCheckFromApiModel maps the route health check returned by the api, values the api omits
+// because they are empty are only kept if they were set in the prior health check
+func MapHealthCheckFromApiModel(healthCheck *apimodels.ReverseProxyHealthCheck, prior *ReverseProxyHealthCheck) *ReverseProxyHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+	if prior == nil {
+		prior = &ReverseProxyHealthCheck{}
+	}
+
+	return &ReverseProxyHealthCheck{
+		Type:               readString(healthCheck.Type),
+		Path:               readString(healthCheck.Path),
+		ExpectedStatus:     readInt64(healthCheck.ExpectedStatus, prior.ExpectedStatus),
+		Interval:           readInt64(healthCheck.Interval, prior.Interval),
+		Timeout:            readInt64(healthCheck.Timeout, prior.Timeout),
+		HealthyThreshold:   readInt64(healthCheck.HealthyThreshold, prior.HealthyThreshold),
+		UnhealthyThreshold: readInt64(healthCheck.UnhealthyThreshold, prior.UnhealthyThreshold),
+	}
+}
+
+func readString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func readInt64(value int64, prior types.Int64) types.Int64 {
+	if value == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+func readBool(value bool, prior types.Bool) types.Bool {
+	if !value && prior.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
 func deleteHost(ctx context.Context, config apiclient.HostConfig, host ReverseProxyHost) diag.Diagnostics {
 	diagnostic := diag.Diagnostics{}
 
@@ -0,0 +1,137 @@
+package reverseproxy
+
+import (
+	"terraform-provider-parallels-desktop/internal/constants"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var TargetsSchemaBlockV0 = schema.ListNestedBlock{
+	MarkdownDescription: "Reverse proxy route targets, the traffic is balanced between them using the `load_balancing` strategy. Conflicts with `target_host` and `target_vm_id`",
+	Description:         "Reverse proxy route targets, the traffic is balanced between them using the load_balancing strategy. Conflicts with target_host and target_vm_id",
+	Validators: []validator.List{
+		listvalidator.ConflictsWith(path.Expressions{
+			path.MatchRelative().AtParent().AtName("target_host"),
+			path.MatchRelative().AtParent().AtName("target_vm_id"),
+		}...),
+	},
+	NestedObject: schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"vm_id": schema.StringAttribute{
+				MarkdownDescription: "Target VM id, the reverse proxy resolves the VM internal address",
+				Description:         "Target VM id, the reverse proxy resolves the VM internal address",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRelative().AtParent().AtName("vm_id"),
+						path.MatchRelative().AtParent().AtName("host"),
+					}...),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Target host address",
+				Description:         "Target host address",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRelative().AtParent().AtName("vm_id"),
+						path.MatchRelative().AtParent().AtName("host"),
+					}...),
+				},
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Target port, defaults to the route `target_port`",
+				Description:         "Target port, defaults to the route target_port",
+				Optional:            true,
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "Target weight, targets with a higher weight receive more traffic. Defaults to `1`",
+				Description:         "Target weight, targets with a higher weight receive more traffic. Defaults to 1",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"drain": schema.BoolAttribute{
+				MarkdownDescription: "Drain the target, it will not receive new connections but the open ones are kept until they finish",
+				Description:         "Drain the target, it will not receive new connections but the open ones are kept until they finish",
+				Optional:            true,
+			},
+		},
+	},
+}
+
+var LoadBalancingAttributeV0 = schema.StringAttribute{
+	MarkdownDescription: "Strategy used to balance the traffic between the route targets, `round_robin` or `least_connections`. Defaults to `round_robin`",
+	Description:         "Strategy used to balance the traffic between the route targets, round_robin or least_connections. Defaults to round_robin",
+	Optional:            true,
+	Validators: []validator.String{
+		stringvalidator.OneOf(constants.ReverseProxyLoadBalancingRoundRobin, constants.ReverseProxyLoadBalancingLeastConnections),
+		stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("targets")),
+	},
+}
+
+var HealthCheckSchemaBlockV0 = schema.SingleNestedBlock{
+	MarkdownDescription: "Active health check of the route targets, unhealthy targets stop receiving traffic until they recover",
+	Description:         "Active health check of the route targets, unhealthy targets stop receiving traffic until they recover",
+	Attributes: map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Health check type, `http` or `tcp`. A `tcp` check only opens a connection to the target",
+			Description:         "Health check type, http or tcp. A tcp check only opens a connection to the target",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(constants.ReverseProxyHealthCheckHttp, constants.ReverseProxyHealthCheckTcp),
+			},
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Path requested by the `http` health check",
+			Description:         "Path requested by the http health check",
+			Optional:            true,
+		},
+		"expected_status": schema.Int64Attribute{
+			MarkdownDescription: "Status code the `http` health check expects, defaults to any 2xx status",
+			Description:         "Status code the http health check expects, defaults to any 2xx status",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(100, 599),
+			},
+		},
+		"interval": schema.Int64Attribute{
+			MarkdownDescription: "Seconds between health checks",
+			Description:         "Seconds between health checks",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Seconds to wait for the health check to answer",
+			Description:         "Seconds to wait for the health check to answer",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"healthy_threshold": schema.Int64Attribute{
+			MarkdownDescription: "Consecutive successful checks for an unhealthy target to receive traffic again",
+			Description:         "Consecutive successful checks for an unhealthy target to receive traffic again",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"unhealthy_threshold": schema.Int64Attribute{
+			MarkdownDescription: "Consecutive failed checks for a target to stop receiving traffic",
+			Description:         "Consecutive failed checks for a target to stop receiving traffic",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	},
+}
@@ -10,7 +10,12 @@ import (
 var TcpRouteSchemaBlockV0 = schema.SingleNestedBlock{
 	MarkdownDescription: "Parallels Desktop DevOps Reverse Proxy TCP Route configuration",
 	Description:         "Parallels Desktop DevOps Reverse Proxy TCP Route configuration",
+	Blocks: map[string]schema.Block{
+		"targets":      TargetsSchemaBlockV0,
+		"health_check": HealthCheckSchemaBlockV0,
+	},
 	Attributes: map[string]schema.Attribute{
+		"load_balancing": LoadBalancingAttributeV0,
 		"target_host": schema.StringAttribute{
 			MarkdownDescription: "Reverse proxy host",
 			Description:         "Reverse proxy host",
@@ -3,6 +3,7 @@ package sharedfolder
 import (
 	"context"
 	"encoding/json"
+	"sort"
 
 	"terraform-provider-parallels-desktop/internal/apiclient"
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
@@ -22,6 +23,88 @@ type SharedFolder struct {
 	Disabled    types.Bool   `tfsdk:"disabled"`
 }
 
+// NewFromVirtualMachine maps the machine shared folders sorted by name
+func NewFromVirtualMachine(vm *apimodels.VirtualMachine) []*SharedFolder {
+	names := make([]string, 0, len(vm.HostSharedFolders.Folders))
+	for name := range vm.HostSharedFolders.Folders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*SharedFolder, 0, len(names))
+	for _, name := range names {
+		folder := vm.HostSharedFolders.Folders[name]
+		sharedFolder := SharedFolder{
+			Name:        types.StringValue(name),
+			Path:        types.StringValue(folder.Path),
+			Readonly:    types.BoolNull(),
+			Description: types.StringNull(),
+			Disabled:    types.BoolNull(),
+		}
+		// only the values that differ from the defaults are set so an imported folder matches a
+		// configuration that leaves them out
+		if folder.Mode == "ro" {
+			sharedFolder.Readonly = types.BoolValue(true)
+		}
+		if !folder.Enabled {
+			sharedFolder.Disabled = types.BoolValue(true)
+		}
+		if folder.Description != "" {
+			sharedFolder.Description = types.StringValue(folder.Description)
+		}
+		result = append(result, &sharedFolder)
+	}
+
+	return result
+}
+
+// RefreshFromVirtualMachine updates the shared folders in the state with the ones currently
+// set in the machine, folders removed from the machine are dropped so the plan adds them back.
+// Folders only present in the machine are not managed by terraform and are ignored
+func RefreshFromVirtualMachine(vm *apimodels.VirtualMachine, stateSharedFolders []*SharedFolder) []*SharedFolder {
+	if stateSharedFolders == nil {
+		return nil
+	}
+
+	result := make([]*SharedFolder, 0, len(stateSharedFolders))
+	for _, stateFolder := range stateSharedFolders {
+		folder, ok := vm.HostSharedFolders.Folders[stateFolder.Name.ValueString()]
+		if !ok {
+			continue
+		}
+
+		sharedFolder := *stateFolder
+		sharedFolder.Path = types.StringValue(folder.Path)
+		// readonly and disabled are not computed, they are only refreshed when they are set or
+		// leaving them out of the configuration would show a diff on every plan
+		if !stateFolder.Readonly.IsNull() {
+			sharedFolder.Readonly = types.BoolValue(folder.Mode == "ro")
+		}
+		if !stateFolder.Disabled.IsNull() {
+			sharedFolder.Disabled = types.BoolValue(!folder.Enabled)
+		}
+		if folder.Description != "" || !stateFolder.Description.IsNull() {
+			sharedFolder.Description = types.StringValue(folder.Description)
+		}
+		result = append(result, &sharedFolder)
+	}
+
+	return result
+}
+
+// Equals returns true if both shared folders have the same values
+func (s *SharedFolder) Equals(other *SharedFolder) bool {
+	if other == nil {
+		return false
+	}
+
+	return s.Name.Equal(other.Name) &&
+		s.Path.Equal(other.Path) &&
+		s.Readonly.Equal(other.Readonly) &&
+		s.Description.Equal(other.Description) &&
+		s.Disabled.Equal(other.Disabled)
+}
+
 func (s *SharedFolder) Elements(ctx context.Context) []attr.Value {
 	attrs := []attr.Value{
 		s.Name,
@@ -0,0 +1,33 @@
+package sshtunnel
+
+import (
+	"terraform-provider-parallels-desktop/internal/ssh"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SshTunnel struct {
+	Host       types.String `tfsdk:"host"`
+	Port       types.String `tfsdk:"port"`
+	User       types.String `tfsdk:"user"`
+	Password   types.String `tfsdk:"password"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	RemoteHost types.String `tfsdk:"remote_host"`
+}
+
+// GetConfig returns the tunnel configuration used by the api client, or nil if the block
+// is not set or its user is not known yet and the api should be called directly
+func (t *SshTunnel) GetConfig() *ssh.TunnelConfig {
+	if t == nil || t.User.ValueString() == "" {
+		return nil
+	}
+
+	return &ssh.TunnelConfig{
+		Host:       t.Host.ValueString(),
+		Port:       t.Port.ValueString(),
+		User:       t.User.ValueString(),
+		Password:   t.Password.ValueString(),
+		PrivateKey: t.PrivateKey.ValueString(),
+		RemoteHost: t.RemoteHost.ValueString(),
+	}
+}
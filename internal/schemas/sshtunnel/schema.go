@@ -0,0 +1,95 @@
+package sshtunnel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	action_schema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	SchemaName  = "ssh_tunnel"
+	SchemaBlock = schema.SingleNestedBlock{
+		MarkdownDescription: "SSH tunnel block, when set the Parallels Desktop API is reached by forwarding a local port to the API port through a ssh connection. Use it when the API is only listening on the host loopback address or is not exposed to the network",
+		Description:         "SSH tunnel block, when set the Parallels Desktop API is reached by forwarding a local port to the API port through a ssh connection. Use it when the API is only listening on the host loopback address or is not exposed to the network",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "SSH host address, defaults to the API host",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "SSH port, defaults to `22`",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "SSH user",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "SSH password",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key")),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "SSH private key",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"remote_host": schema.StringAttribute{
+				MarkdownDescription: "Address the API is listening on as seen from the SSH host, defaults to `127.0.0.1`",
+				Optional:            true,
+			},
+		},
+	}
+
+	// ActionSchemaBlock is the same ssh tunnel block for actions, they use their own schema types
+	ActionSchemaBlock = action_schema.SingleNestedBlock{
+		MarkdownDescription: SchemaBlock.MarkdownDescription,
+		Description:         SchemaBlock.Description,
+		Attributes: map[string]action_schema.Attribute{
+			"host": action_schema.StringAttribute{
+				MarkdownDescription: "SSH host address, defaults to the API host",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": action_schema.StringAttribute{
+				MarkdownDescription: "SSH port, defaults to `22`",
+				Optional:            true,
+			},
+			"user": action_schema.StringAttribute{
+				MarkdownDescription: "SSH user",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": action_schema.StringAttribute{
+				MarkdownDescription: "SSH password",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key")),
+				},
+			},
+			"private_key": action_schema.StringAttribute{
+				MarkdownDescription: "SSH private key",
+				Optional:            true,
+			},
+			"remote_host": action_schema.StringAttribute{
+				MarkdownDescription: "Address the API is listening on as seen from the SSH host, defaults to `127.0.0.1`",
+				Optional:            true,
+			},
+		},
+	}
+)
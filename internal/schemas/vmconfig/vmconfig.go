@@ -2,6 +2,7 @@ package vmconfig
 
 import (
 	"context"
+	"strings"
 
 	"terraform-provider-parallels-desktop/internal/apiclient"
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
@@ -21,6 +22,33 @@ type VmConfig struct {
 	AutoStartOnHost types.Bool `tfsdk:"auto_start_on_host"`
 }
 
+// NewFromVirtualMachine maps the machine settings into a config block
+func NewFromVirtualMachine(vm *apimodels.VirtualMachine) *VmConfig {
+	return &VmConfig{
+		StartHeadless:   types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.StartupView, "headless")),
+		EnableRosetta:   types.BoolValue(strings.EqualFold(vm.Advanced.RosettaLinux, "on")),
+		PauseIdle:       types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.PauseIdle, "on")),
+		AutoStartOnHost: types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.Autostart, "start-host")),
+	}
+}
+
+// RefreshFromVirtualMachine updates the toggles managed by terraform with the ones
+// currently set in the machine, values the machine does not report are left untouched
+func (s *VmConfig) RefreshFromVirtualMachine(vm *apimodels.VirtualMachine) {
+	if !s.StartHeadless.IsNull() && vm.StartupAndShutdown.StartupView != "" {
+		s.StartHeadless = types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.StartupView, "headless"))
+	}
+	if !s.EnableRosetta.IsNull() && vm.Advanced.RosettaLinux != "" {
+		s.EnableRosetta = types.BoolValue(strings.EqualFold(vm.Advanced.RosettaLinux, "on"))
+	}
+	if !s.PauseIdle.IsNull() && vm.StartupAndShutdown.PauseIdle != "" {
+		s.PauseIdle = types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.PauseIdle, "on"))
+	}
+	if !s.AutoStartOnHost.IsNull() && vm.StartupAndShutdown.Autostart != "" {
+		s.AutoStartOnHost = types.BoolValue(strings.EqualFold(vm.StartupAndShutdown.Autostart, "start-host"))
+	}
+}
+
 func (s *VmConfig) Schema() map[string]schema.Attribute {
 	return SchemaBlock.Attributes
 }
@@ -115,7 +143,7 @@ func (s *VmConfig) toggle(ctx context.Context, config apiclient.HostConfig, vm a
 		op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
 		op.WithGroup("cmd")
 		op.WithOperation("set")
-		if s.StartHeadless.ValueBool() {
+		if s.EnableRosetta.ValueBool() {
 			op.WithOption("rosetta-linux", "on")
 		} else {
 			op.WithOption("rosetta-linux", "off")
@@ -125,7 +153,7 @@ func (s *VmConfig) toggle(ctx context.Context, config apiclient.HostConfig, vm a
 		op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
 		op.WithGroup("cmd")
 		op.WithOperation("set")
-		if s.StartHeadless.ValueBool() {
+		if s.PauseIdle.ValueBool() {
 			op.WithOption("pause-idle", "on")
 		} else {
 			op.WithOption("pause-idle", "off")
@@ -135,7 +163,7 @@ func (s *VmConfig) toggle(ctx context.Context, config apiclient.HostConfig, vm a
 		op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
 		op.WithGroup("cmd")
 		op.WithOperation("set")
-		if s.StartHeadless.ValueBool() {
+		if s.AutoStartOnHost.ValueBool() {
 			op.WithOption("autostart", "start-host")
 		} else {
 			op.WithOption("autostart", "off")
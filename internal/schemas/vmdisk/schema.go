@@ -0,0 +1,37 @@
+package vmdisk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	SchemaName  = "disk"
+	SchemaBlock = schema.ListNestedBlock{
+		MarkdownDescription: "Additional disks block, this is used to add extra hard disks to the virtual machine, changing the disks will recreate the virtual machine",
+		Description:         "Additional disks block, this is used to add extra hard disks to the virtual machine, changing the disks will recreate the virtual machine",
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"size": schema.StringAttribute{
+					MarkdownDescription: "The size of the disk in megabytes.",
+					Required:            true,
+					Description:         "The size of the disk in megabytes.",
+				},
+				"interface": schema.StringAttribute{
+					MarkdownDescription: "The disk interface, one of sata, scsi, nvme or ide.",
+					Optional:            true,
+					Description:         "The disk interface, one of sata, scsi, nvme or ide.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("sata", "scsi", "nvme", "ide"),
+					},
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,47 @@
+package vmdisk
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VmDisk struct {
+	Size      types.String `tfsdk:"size"`
+	Interface types.String `tfsdk:"interface"`
+}
+
+func (s *VmDisk) Apply(ctx context.Context, config apiclient.HostConfig, vm apimodels.VirtualMachine) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+
+	if vm.State != "stopped" {
+		diagnostic.AddError("vm must be stopped", "vm must be stopped")
+		return diagnostic
+	}
+
+	vmConfigRequest := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	op.WithOption("device-add", "hdd")
+	op.WithOption("size", s.Size.ValueString())
+	if s.Interface.ValueString() != "" {
+		op.WithOption("iface", s.Interface.ValueString())
+	}
+	op.Append()
+
+	_, resultDiagnostic := apiclient.ConfigureMachine(ctx, config, vm.ID, vmConfigRequest)
+	if resultDiagnostic.HasError() {
+		diagnostic.Append(resultDiagnostic...)
+		return diagnostic
+	}
+
+	tflog.Info(ctx, "Added disk with "+s.Size.ValueString()+"MB to vm "+vm.Name)
+
+	return diagnostic
+}
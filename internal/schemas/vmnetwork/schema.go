@@ -0,0 +1,37 @@
+package vmnetwork
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	SchemaName  = "network_adapter"
+	SchemaBlock = schema.ListNestedBlock{
+		MarkdownDescription: "Network adapters block, the first block configures the default adapter and any other block adds a new adapter to the virtual machine",
+		Description:         "Network adapters block, the first block configures the default adapter and any other block adds a new adapter to the virtual machine",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The network type, one of shared, bridged or host.",
+					Required:            true,
+					Description:         "The network type, one of shared, bridged or host.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("shared", "bridged", "host"),
+					},
+				},
+				"interface": schema.StringAttribute{
+					MarkdownDescription: "The host interface to bridge to, only used with the bridged type.",
+					Optional:            true,
+					Description:         "The host interface to bridge to, only used with the bridged type.",
+				},
+				"mac_address": schema.StringAttribute{
+					MarkdownDescription: "The adapter MAC address, if empty one will be generated.",
+					Optional:            true,
+					Description:         "The adapter MAC address, if empty one will be generated.",
+				},
+			},
+		},
+	}
+)
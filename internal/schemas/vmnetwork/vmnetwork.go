@@ -0,0 +1,94 @@
+package vmnetwork
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type VmNetworkAdapter struct {
+	Type       types.String `tfsdk:"type"`
+	Interface  types.String `tfsdk:"interface"`
+	MacAddress types.String `tfsdk:"mac_address"`
+}
+
+func (s *VmNetworkAdapter) Equals(other *VmNetworkAdapter) bool {
+	if other == nil {
+		return false
+	}
+
+	return s.Type.ValueString() == other.Type.ValueString() &&
+		s.Interface.ValueString() == other.Interface.ValueString() &&
+		s.MacAddress.ValueString() == other.MacAddress.ValueString()
+}
+
+// Apply configures the adapter at the given index, if add is set a new adapter is created instead
+func (s *VmNetworkAdapter) Apply(ctx context.Context, config apiclient.HostConfig, vm apimodels.VirtualMachine, index int, add bool) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+
+	if vm.State != "stopped" {
+		diagnostic.AddError("vm must be stopped", "vm must be stopped")
+		return diagnostic
+	}
+
+	vmConfigRequest := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	if add {
+		op.WithOption("device-add", "net")
+	} else {
+		op.WithOption("device-set", fmt.Sprintf("net%d", index))
+	}
+	op.WithOption("type", s.Type.ValueString())
+	if s.Interface.ValueString() != "" {
+		op.WithOption("iface", s.Interface.ValueString())
+	}
+	if s.MacAddress.ValueString() != "" {
+		op.WithOption("mac", s.MacAddress.ValueString())
+	}
+	op.Append()
+
+	_, resultDiagnostic := apiclient.ConfigureMachine(ctx, config, vm.ID, vmConfigRequest)
+	if resultDiagnostic.HasError() {
+		diagnostic.Append(resultDiagnostic...)
+		return diagnostic
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Configured network adapter net%d on vm %s", index, vm.Name))
+
+	return diagnostic
+}
+
+// Remove deletes the adapter at the given index from the virtual machine
+func Remove(ctx context.Context, config apiclient.HostConfig, vm apimodels.VirtualMachine, index int) diag.Diagnostics {
+	diagnostic := diag.Diagnostics{}
+
+	if vm.State != "stopped" {
+		diagnostic.AddError("vm must be stopped", "vm must be stopped")
+		return diagnostic
+	}
+
+	vmConfigRequest := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(vmConfigRequest)
+	op.WithGroup("cmd")
+	op.WithOperation("set")
+	op.WithOption("device-del", fmt.Sprintf("net%d", index))
+	op.Append()
+
+	_, resultDiagnostic := apiclient.ConfigureMachine(ctx, config, vm.ID, vmConfigRequest)
+	if resultDiagnostic.HasError() {
+		diagnostic.Append(resultDiagnostic...)
+		return diagnostic
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removed network adapter net%d from vm %s", index, vm.Name))
+
+	return diagnostic
+}
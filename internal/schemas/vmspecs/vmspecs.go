@@ -2,6 +2,8 @@ package vmspecs
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"terraform-provider-parallels-desktop/internal/apiclient"
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
@@ -20,6 +22,27 @@ type VmSpecs struct {
 	DiskSize   types.String `tfsdk:"disk_size"`
 }
 
+// NewFromVirtualMachine maps the machine hardware into a specs block
+func NewFromVirtualMachine(vm *apimodels.VirtualMachine) *VmSpecs {
+	return &VmSpecs{
+		Force:      types.BoolNull(),
+		CpuCount:   types.StringValue(strconv.FormatInt(vm.Hardware.CPU.Cpus, 10)),
+		MemorySize: types.StringValue(strings.ReplaceAll(vm.Hardware.Memory.Size, "Mb", "")),
+		DiskSize:   types.StringValue(strings.ReplaceAll(vm.Hardware.Hdd0.Size, "Mb", "")),
+	}
+}
+
+// RefreshFromVirtualMachine updates the values managed by terraform with the ones
+// currently set in the machine so any change done outside terraform shows up in the plan
+func (s *VmSpecs) RefreshFromVirtualMachine(vm *apimodels.VirtualMachine) {
+	if !s.CpuCount.IsNull() && vm.Hardware.CPU.Cpus > 0 {
+		s.CpuCount = types.StringValue(strconv.FormatInt(vm.Hardware.CPU.Cpus, 10))
+	}
+	if !s.MemorySize.IsNull() && vm.Hardware.Memory.Size != "" {
+		s.MemorySize = types.StringValue(strings.ReplaceAll(vm.Hardware.Memory.Size, "Mb", ""))
+	}
+}
+
 func (s *VmSpecs) Schema() map[string]schema.Attribute {
 	return SchemaBlock.Attributes
 }
@@ -0,0 +1,177 @@
+package ssh
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// TunnelConfig describes how to reach the ssh host used to tunnel the api calls, an empty host
+// uses the api host and an empty remote host forwards to the loopback address of the ssh host
+type TunnelConfig struct {
+	Host       string `json:"host,omitempty"`
+	Port       string `json:"port,omitempty"`
+	User       string `json:"user"`
+	Password   string `json:"-"`
+	PrivateKey string `json:"-"`
+	RemoteHost string `json:"remote_host,omitempty"`
+}
+
+// SshTunnel forwards a local ephemeral port to a remote address through a ssh connection, the
+// tunnel closes itself once it has not been used for the idle timeout
+type SshTunnel struct {
+	conn          *ssh.Client
+	listener      net.Listener
+	remoteAddress string
+	idleTimeout   time.Duration
+	mutex         sync.Mutex
+	activeConns   int
+	lastUsed      time.Time
+	closed        bool
+}
+
+// OpenTunnel connects to the ssh host and starts forwarding a local ephemeral port to the remote
+// address, the remote address is resolved by the ssh host so it can be a loopback address
+func (c *SshClient) OpenTunnel(remoteAddress string, idleTimeout time.Duration) (*SshTunnel, error) {
+	conn, err := ssh.Dial("tcp", c.BaseAddress(), c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	tunnel := &SshTunnel{
+		conn:          conn,
+		listener:      listener,
+		remoteAddress: remoteAddress,
+		idleTimeout:   idleTimeout,
+		lastUsed:      time.Now(),
+	}
+
+	go tunnel.accept()
+	if idleTimeout > 0 {
+		go tunnel.closeWhenIdle()
+	}
+
+	return tunnel, nil
+}
+
+// LocalAddress returns the local address forwarded to the remote address
+func (t *SshTunnel) LocalAddress() string {
+	return t.listener.Addr().String()
+}
+
+// IsClosed returns true if the tunnel was closed and can no longer be used
+func (t *SshTunnel) IsClosed() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.closed
+}
+
+// Acquire marks the tunnel as in use so it is not closed while a connection is opened through it,
+// it returns false if the tunnel is already closed
+func (t *SshTunnel) Acquire() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.closed {
+		return false
+	}
+
+	t.activeConns++
+	t.lastUsed = time.Now()
+	return true
+}
+
+// Release marks the use started by Acquire as done, the idle timeout starts again from now
+func (t *SshTunnel) Release() {
+	t.track(-1)
+}
+
+func (t *SshTunnel) Close() error {
+	t.mutex.Lock()
+	if t.closed {
+		t.mutex.Unlock()
+		return nil
+	}
+	t.closed = true
+	t.mutex.Unlock()
+
+	listenerErr := t.listener.Close()
+	if err := t.conn.Close(); err != nil {
+		return err
+	}
+
+	return listenerErr
+}
+
+func (t *SshTunnel) accept() {
+	for {
+		local, err := t.listener.Accept()
+		if err != nil {
+			// the listener was closed
+			return
+		}
+
+		go t.forward(local)
+	}
+}
+
+func (t *SshTunnel) forward(local net.Conn) {
+	t.track(1)
+	defer t.track(-1)
+	defer local.Close()
+
+	remote, err := t.conn.Dial("tcp", t.remoteAddress)
+	if err != nil {
+		return
+	}
+	defer remote.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(remote, local)
+		_ = remote.Close()
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(local, remote)
+		_ = local.Close()
+	}()
+	wg.Wait()
+}
+
+func (t *SshTunnel) track(delta int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.activeConns += delta
+	t.lastUsed = time.Now()
+}
+
+func (t *SshTunnel) closeWhenIdle() {
+	ticker := time.NewTicker(t.idleTimeout / 4)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		t.mutex.Lock()
+		idle := t.activeConns == 0 && time.Since(t.lastUsed) > t.idleTimeout
+		closed := t.closed
+		t.mutex.Unlock()
+
+		if closed {
+			return
+		}
+		if idle {
+			_ = t.Close()
+			return
+		}
+	}
+}
@@ -9,6 +9,19 @@ const (
 	EventCloneVm             TelemetryEvent = "PD-TERRAFORM-PROVIDER::CLONE_VM"
 	EventDataSourceVm        TelemetryEvent = "PD-TERRAFORM-PROVIDER::DATA_SOURCE_VM"
 	EventVirtualMachineState TelemetryEvent = "PD-TERRAFORM-PROVIDER::VIRTUAL_MACHINE_STATE"
+	EventVmSnapshot          TelemetryEvent = "PD-TERRAFORM-PROVIDER::VM_SNAPSHOT"
+	EventVmTemplate          TelemetryEvent = "PD-TERRAFORM-PROVIDER::VM_TEMPLATE"
+	EventVm                  TelemetryEvent = "PD-TERRAFORM-PROVIDER::VM"
+	EventCatalogPush         TelemetryEvent = "PD-TERRAFORM-PROVIDER::CATALOG_PUSH"
+	EventCatalogCache        TelemetryEvent = "PD-TERRAFORM-PROVIDER::CATALOG_CACHE"
+	EventReverseProxyHost    TelemetryEvent = "PD-TERRAFORM-PROVIDER::REVERSE_PROXY_HOST"
+	EventOrchestratorHost    TelemetryEvent = "PD-TERRAFORM-PROVIDER::ORCHESTRATOR_HOST"
+	EventHostDrain           TelemetryEvent = "PD-TERRAFORM-PROVIDER::HOST_DRAIN"
+	EventUser                TelemetryEvent = "PD-TERRAFORM-PROVIDER::USER"
+	EventRole                TelemetryEvent = "PD-TERRAFORM-PROVIDER::ROLE"
+	EventClaim               TelemetryEvent = "PD-TERRAFORM-PROVIDER::CLAIM"
+	EventApiKey              TelemetryEvent = "PD-TERRAFORM-PROVIDER::API_KEY"
+	EventUserRoleAttachment  TelemetryEvent = "PD-TERRAFORM-PROVIDER::USER_ROLE_ATTACHMENT"
 )
 
 type TelemetryEventMode string
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserDataSourceModelV0 represents the data source schema for the user data source.
+type UserDataSourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Username      types.String                  `tfsdk:"username"`
+	Name          types.String                  `tfsdk:"name"`
+	Email         types.String                  `tfsdk:"email"`
+	Roles         []types.String                `tfsdk:"roles"`
+	Claims        []types.String                `tfsdk:"claims"`
+}
+
+// FromApiModel sets the user details, roles and claims from the host
+func (m *UserDataSourceModelV0) FromApiModel(user *apimodels.UserResponse) {
+	m.ID = types.StringValue(user.ID)
+	m.Username = types.StringValue(user.Username)
+	m.Name = types.StringValue(user.Name)
+	m.Email = types.StringValue(user.Email)
+	m.Roles = toStringValues(user.Roles)
+	m.Claims = toStringValues(user.Claims)
+}
+
+func toStringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserResourceModelV0 represents the resource schema for the user resource.
+type UserResourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	Username      types.String                  `tfsdk:"username"`
+	Name          types.String                  `tfsdk:"name"`
+	Email         types.String                  `tfsdk:"email"`
+	Password      types.String                  `tfsdk:"password"`
+}
+
+// ToRequest converts the model to the request used to create the user
+func (m *UserResourceModelV0) ToRequest() apimodels.UserRequest {
+	return apimodels.UserRequest{
+		Username: m.Username.ValueString(),
+		Name:     m.Name.ValueString(),
+		Email:    m.Email.ValueString(),
+		Password: m.Password.ValueString(),
+	}
+}
+
+// FromApiModel sets the user details from the host, the password is never returned and is kept
+func (m *UserResourceModelV0) FromApiModel(user *apimodels.UserResponse) {
+	m.ID = types.StringValue(user.ID)
+	m.Username = types.StringValue(user.Username)
+	m.Name = types.StringValue(user.Name)
+	m.Email = types.StringValue(user.Email)
+}
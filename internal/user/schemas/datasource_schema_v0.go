@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var UserDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "User Data Source, looks up a user in a Parallels Desktop DevOps host by its id or username",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Required:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "User id",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRoot("id"),
+					path.MatchRoot("username"),
+				}...),
+			},
+		},
+		"username": schema.StringAttribute{
+			MarkdownDescription: "User username",
+			Optional:            true,
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "User name",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "User email",
+			Computed:            true,
+		},
+		"roles": schema.ListAttribute{
+			MarkdownDescription: "Roles given to the user",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"claims": schema.ListAttribute{
+			MarkdownDescription: "Claims given to the user",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	},
+}
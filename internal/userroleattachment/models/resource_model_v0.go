@@ -0,0 +1,38 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserRoleAttachmentResourceModelV0 represents the resource schema for the user role attachment resource.
+type UserRoleAttachmentResourceModelV0 struct {
+	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host          types.String                  `tfsdk:"host"`
+	ID            types.String                  `tfsdk:"id"`
+	UserId        types.String                  `tfsdk:"user_id"`
+	Role          types.String                  `tfsdk:"role"`
+	RoleId        types.String                  `tfsdk:"role_id"`
+}
+
+// SetID sets the id of the attachment from the user id and the role name
+func (m *UserRoleAttachmentResourceModelV0) SetID() {
+	m.ID = types.StringValue(fmt.Sprintf("%s/%s", m.UserId.ValueString(), m.Role.ValueString()))
+}
+
+// HasRole returns true if the role of the attachment is in the roles given to the user
+func (m *UserRoleAttachmentResourceModelV0) HasRole(roles []string) bool {
+	for _, role := range roles {
+		if strings.EqualFold(role, m.Role.ValueString()) {
+			return true
+		}
+	}
+
+	return false
+}
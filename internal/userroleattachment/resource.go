@@ -0,0 +1,269 @@
+package userroleattachment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+	resource_models "terraform-provider-parallels-desktop/internal/userroleattachment/models"
+	"terraform-provider-parallels-desktop/internal/userroleattachment/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &UserRoleAttachmentResource{}
+	_ resource.ResourceWithImportState = &UserRoleAttachmentResource{}
+)
+
+func NewUserRoleAttachmentResource() resource.Resource {
+	return &UserRoleAttachmentResource{}
+}
+
+// UserRoleAttachmentResource defines the resource implementation.
+type UserRoleAttachmentResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *UserRoleAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_attachment"
+}
+
+func (r *UserRoleAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetUserRoleAttachmentSchemaV0(ctx)
+}
+
+func (r *UserRoleAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *UserRoleAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.UserRoleAttachmentResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventUserRoleAttachment, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+
+	user, diag := apiclient.GetUser(ctx, hostConfig, data.UserId.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("user_id"), "User not found", "Could not find the user "+data.UserId.ValueString()+" in the host")
+		return
+	}
+	if data.HasRole(user.Roles) {
+		resp.Diagnostics.AddError("role already attached", fmt.Sprintf("user %s already has the role %s, import it to manage it with terraform", user.Username, data.Role.ValueString()))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adding role %s to user %s", data.Role.ValueString(), data.UserId.ValueString()))
+	role, diag := apiclient.AddRoleToUser(ctx, hostConfig, data.UserId.ValueString(), data.Role.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	data.SetID()
+	data.RoleId = types.StringValue(role.ID)
+	if role.ID == "" {
+		resp.Diagnostics.Append(r.setRoleId(ctx, hostConfig, &data)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *UserRoleAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.UserRoleAttachmentResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventUserRoleAttachment, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostConfig := r.getHostConfig(data)
+	user, diag := apiclient.GetUser(ctx, hostConfig, data.UserId.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	// the user was deleted or the role was removed from it outside terraform, it needs to be
+	// attached again
+	if user == nil || !data.HasRole(user.Roles) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.SetID()
+	if data.RoleId.ValueString() == "" {
+		resp.Diagnostics.Append(r.setRoleId(ctx, hostConfig, &data)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *UserRoleAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.UserRoleAttachmentResourceModelV0
+	var currentData resource_models.UserRoleAttachmentResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventUserRoleAttachment, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the user and the role require a replacement, only the connection details can change here
+	data.ID = currentData.ID
+	data.RoleId = currentData.RoleId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *UserRoleAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.UserRoleAttachmentResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventUserRoleAttachment, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId := data.RoleId.ValueString()
+	if roleId == "" {
+		roleId = data.Role.ValueString()
+	}
+
+	if diag := apiclient.RemoveRoleFromUser(ctx, r.getHostConfig(data), data.UserId.ValueString(), roleId); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+}
+
+// ImportState sets the host, credentials, user id and role from the import id in the format
+// [username:password@]host@user_id/role, the Read then rebuilds the rest of the state
+func (r *UserRoleAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, auth, attachmentId, err := common.ParseImportId(req.ID, "user_id/role", "user id and role")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import id", err.Error())
+		return
+	}
+
+	userId, role, found := strings.Cut(attachmentId, "/")
+	if !found || userId == "" || role == "" {
+		resp.Diagnostics.AddError("Invalid import id", "the attachment needs to include the user id and the role, expected format is [username:password@]host@user_id/role")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), attachmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+	if auth != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authenticator"), auth)...)
+	}
+}
+
+func (r *UserRoleAttachmentResource) getHostConfig(data resource_models.UserRoleAttachmentResourceModelV0) apiclient.HostConfig {
+	return apiclient.HostConfig{
+		Host:                 data.Host.ValueString(),
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+}
+
+// setRoleId looks up the id of the role by its name, the host does not always return it when the
+// role is added to the user and imported attachments only know the name
+func (r *UserRoleAttachmentResource) setRoleId(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.UserRoleAttachmentResourceModelV0) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	role, roleDiag := apiclient.GetRole(ctx, hostConfig, data.Role.ValueString())
+	if roleDiag.HasError() {
+		diagnostics.Append(roleDiag...)
+		return diagnostics
+	}
+	if role == nil {
+		data.RoleId = types.StringNull()
+		return diagnostics
+	}
+
+	data.RoleId = types.StringValue(role.ID)
+	return diagnostics
+}
@@ -2,10 +2,12 @@ package models
 
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 
@@ -16,6 +18,8 @@ import (
 // VirtualMachineStateResourceModel describes the resource data model.
 type VagrantBoxResourceModelV1 struct {
 	Authenticator         *authenticator.Authentication              `tfsdk:"authenticator"`
+	SshTunnel             *sshtunnel.SshTunnel                       `tfsdk:"ssh_tunnel"`
+	Placement             *placement.Placement                       `tfsdk:"placement"`
 	Host                  types.String                               `tfsdk:"host"`
 	Orchestrator          types.String                               `tfsdk:"orchestrator"`
 	ID                    types.String                               `tfsdk:"id"`
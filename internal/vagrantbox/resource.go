@@ -9,15 +9,20 @@ import (
 	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
 	"terraform-provider-parallels-desktop/internal/common"
 	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 	"terraform-provider-parallels-desktop/internal/telemetry"
 	resource_models "terraform-provider-parallels-desktop/internal/vagrantbox/models"
 	"terraform-provider-parallels-desktop/internal/vagrantbox/schemas"
+	"terraform-provider-parallels-desktop/internal/vmplan"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,6 +32,7 @@ import (
 var (
 	_ resource.Resource                = &VagrantBoxResource{}
 	_ resource.ResourceWithImportState = &VagrantBoxResource{}
+	_ resource.ResourceWithModifyPlan  = &VagrantBoxResource{}
 )
 
 func NewVagrantBoxResource() resource.Resource {
@@ -113,6 +119,8 @@ func (r *VagrantBoxResource) Create(ctx context.Context, req resource.CreateRequ
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	if !isOrchestrator {
@@ -153,6 +161,12 @@ func (r *VagrantBoxResource) Create(ctx context.Context, req resource.CreateRequ
 		createVmRequest.Owner = data.Owner.ValueString()
 	}
 
+	if placementDiag := placement.Validate(ctx, hostConfig, data.Placement, ""); placementDiag.HasError() {
+		resp.Diagnostics.Append(placementDiag...)
+		return
+	}
+	createVmRequest.Placement = data.Placement.GetRequest()
+
 	response, createVmDiag := apiclient.CreateVm(ctx, hostConfig, createVmRequest)
 	if createVmDiag.HasError() {
 		resp.Diagnostics.Append(createVmDiag...)
@@ -172,6 +186,7 @@ func (r *VagrantBoxResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("vm was not found", "vm was not found")
 		return
 	}
+	resp.Diagnostics.Append(placement.VerifyPlacement(ctx, hostConfig, data.Placement, createdVM.ID, createdVM.HostId)...)
 
 	// stopping the machine as it might need some operations where the machine needs to be stopped
 	// add anything here in sequence that needs to be done before the machine is started
@@ -344,6 +359,9 @@ func (r *VagrantBoxResource) Create(ctx context.Context, req resource.CreateRequ
 	data.InternalIp = types.StringValue(internalIp)
 
 	data.OsType = types.StringValue(createdVM.OS)
+	if data.Owner.IsUnknown() {
+		data.Owner = types.StringValue(createdVM.User)
+	}
 	if data.OnDestroyScript != nil {
 		for _, script := range data.OnDestroyScript {
 			elements := make([]attr.Value, 0)
@@ -430,6 +448,8 @@ func (r *VagrantBoxResource) Read(ctx context.Context, req resource.ReadRequest,
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -442,9 +462,30 @@ func (r *VagrantBoxResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// the resource was imported, rebuilding the state from the machine
+	if data.Name.IsNull() {
+		data.ID = types.StringValue(vm.ID)
+		data.Owner = types.StringValue(vm.User)
+		data.OsType = types.StringValue(vm.OS)
+		data.ExternalIp = types.StringValue(vm.HostExternalIpAddress)
+		data.InternalIp = types.StringValue(vm.InternalIpAddress)
+		data.Specs = vmspecs.NewFromVirtualMachine(vm)
+		data.Config = vmconfig.NewFromVirtualMachine(vm)
+		data.SharedFolder = sharedfolder.NewFromVirtualMachine(vm)
+	} else {
+		// picking up any change done to the machine outside terraform
+		if data.Specs != nil {
+			data.Specs.RefreshFromVirtualMachine(vm)
+		}
+		if data.Config != nil {
+			data.Config.RefreshFromVirtualMachine(vm)
+		}
+		data.SharedFolder = sharedfolder.RefreshFromVirtualMachine(vm, data.SharedFolder)
+	}
+
 	data.Name = types.StringValue(vm.Name)
 
-	resp.Diagnostics.Append(req.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -502,6 +543,8 @@ func (r *VagrantBoxResource) Update(ctx context.Context, req resource.UpdateRequ
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, getVmDiag := apiclient.GetVm(ctx, hostConfig, currentData.ID.ValueString())
@@ -533,8 +576,9 @@ func (r *VagrantBoxResource) Update(ctx context.Context, req resource.UpdateRequ
 	configChanges := common.VmConfigBlockHasChanges(ctx, hostConfig, vm, data.Config, currentData.Config)
 	specsChanges := common.SpecsBlockHasChanges(ctx, hostConfig, vm, data.Specs, currentData.Specs)
 	prlctlChanges := common.PrlCtlBlockHasChanges(ctx, hostConfig, vm, data.PrlCtl, currentData.PrlCtl)
+	sharedFoldersChanges := common.SharedFoldersBlockHasChanges(ctx, data.SharedFolder, currentData.SharedFolder)
 	postProcessorScriptChanges := common.PostProcessorHasChanges(ctx, data.PostProcessorScripts, currentData.PostProcessorScripts)
-	if specsChanges || configChanges || prlctlChanges || nameChanges.HasChanges() {
+	if specsChanges || configChanges || prlctlChanges || sharedFoldersChanges || nameChanges.HasChanges() {
 		requireShutdown = true
 	}
 
@@ -578,6 +622,14 @@ func (r *VagrantBoxResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
+	// Processing shared folders
+	if sharedFoldersChanges {
+		if diag := common.SharedFoldersBlockOnUpdate(ctx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
 	// Restarting the machine if needed
 	if needsRestart || (vm.State == "stopped" && currentState == "running") {
 		if newVm, startDiags := common.EnsureMachineRunning(ctx, hostConfig, vm); startDiags.HasError() {
@@ -588,12 +640,6 @@ func (r *VagrantBoxResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
-	// Processing shared folders
-	if diag := common.SharedFoldersBlockOnUpdate(ctx, hostConfig, vm, data.SharedFolder, currentData.SharedFolder); diag.HasError() {
-		resp.Diagnostics.Append(diag...)
-		return
-	}
-
 	// Running post processor changes
 	if postProcessorScriptChanges {
 		if diag := common.RunPostProcessorScript(ctx, hostConfig, vm, data.PostProcessorScripts); diag.HasError() {
@@ -755,12 +801,19 @@ func (r *VagrantBoxResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	tflog.Info(ctx, "Updated vm with id "+data.ID.ValueString()+" and name "+data.Name.ValueString())
 
+	// the first update after an import has set all the values we could not read from the machine
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, planmodifiers.ImportedPrivateStateKey, nil)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+func (r *VagrantBoxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	vmplan.ModifyPlan(ctx, r.provider, req, resp, vmplan.Options{})
+}
+
 func (r *VagrantBoxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data resource_models.VagrantBoxResourceModelV1
 	// Read Terraform prior state data into the model
@@ -811,6 +864,8 @@ func (r *VagrantBoxResource) Delete(ctx context.Context, req resource.DeleteRequ
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
@@ -874,7 +929,7 @@ func (r *VagrantBoxResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *VagrantBoxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	common.ImportVmState(ctx, r.provider, req, resp)
 }
 
 func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.VagrantBoxResourceModelV1, hostConfig apiclient.HostConfig, targetVm *apimodels.VirtualMachine) ([]reverseproxy.ReverseProxyHost, diag.Diagnostics) {
@@ -921,11 +976,18 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.Va
 			host.TcpRoute.TargetPort = data.ReverseProxyHosts[i].TcpRoute.TargetPort
 			host.TcpRoute.TargetHost = types.StringValue(internalIp)
 			host.TcpRoute.TargetVmId = types.StringValue(emptyString)
+			host.TcpRoute.HealthCheck = data.ReverseProxyHosts[i].TcpRoute.HealthCheck
+			// a route with targets balances between them instead of routing to this machine
+			if len(data.ReverseProxyHosts[i].TcpRoute.Targets) > 0 {
+				host.TcpRoute.TargetHost = types.StringValue(emptyString)
+				host.TcpRoute.Targets = data.ReverseProxyHosts[i].TcpRoute.Targets
+				host.TcpRoute.LoadBalancing = data.ReverseProxyHosts[i].TcpRoute.LoadBalancing
+			}
 		}
 
 		if len(data.ReverseProxyHosts[i].HttpRoute) > 0 {
 			host.HttpRoute = make([]*reverseproxy.ReverseProxyHttpRoute, len(data.ReverseProxyHosts[i].HttpRoute))
-			for j := range modifiedHosts[i].HttpRoute {
+			for j := range data.ReverseProxyHosts[i].HttpRoute {
 				httpRoute := reverseproxy.ReverseProxyHttpRoute{}
 				httpRoute.Path = data.ReverseProxyHosts[i].HttpRoute[j].Path
 				httpRoute.TargetHost = types.StringValue(internalIp)
@@ -935,6 +997,12 @@ func updateReverseProxyHostsTarget(ctx context.Context, data *resource_models.Va
 				httpRoute.Schema = data.ReverseProxyHosts[i].HttpRoute[j].Schema
 				httpRoute.RequestHeaders = data.ReverseProxyHosts[i].HttpRoute[j].RequestHeaders
 				httpRoute.ResponseHeaders = data.ReverseProxyHosts[i].HttpRoute[j].ResponseHeaders
+				httpRoute.HealthCheck = data.ReverseProxyHosts[i].HttpRoute[j].HealthCheck
+				if len(data.ReverseProxyHosts[i].HttpRoute[j].Targets) > 0 {
+					httpRoute.TargetHost = types.StringValue(emptyString)
+					httpRoute.Targets = data.ReverseProxyHosts[i].HttpRoute[j].Targets
+					httpRoute.LoadBalancing = data.ReverseProxyHosts[i].HttpRoute[j].LoadBalancing
+				}
 				host.HttpRoute[j] = &httpRoute
 			}
 		}
@@ -3,11 +3,14 @@ package schemas
 import (
 	"context"
 
+	"terraform-provider-parallels-desktop/internal/planmodifiers"
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/placement"
 	"terraform-provider-parallels-desktop/internal/schemas/postprocessorscript"
 	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
 	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
 	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
 	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
 
@@ -26,6 +29,8 @@ func GetResourceSchemaV1(ctx context.Context) schema.Schema {
 		MarkdownDescription: "Parallels Virtual Machine State Resource",
 		Blocks: map[string]schema.Block{
 			authenticator.SchemaName:       authenticator.SchemaBlock,
+			sshtunnel.SchemaName:           sshtunnel.SchemaBlock,
+			placement.SchemaName:           placement.SchemaBlock,
 			vmspecs.SchemaName:             vmspecs.SchemaBlock,
 			postprocessorscript.SchemaName: postprocessorscript.SchemaBlock,
 			"on_destroy_script":            postprocessorscript.SchemaBlock,
@@ -81,7 +86,7 @@ func GetResourceSchemaV1(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Vagrant box name",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRoot("vagrant_file_path")),
@@ -91,7 +96,7 @@ func GetResourceSchemaV1(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Vagrant file path",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRoot("vagrant_file_path")),
@@ -101,21 +106,21 @@ func GetResourceSchemaV1(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Vagrant box version",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"custom_vagrant_config": schema.StringAttribute{
 				MarkdownDescription: "Custom Vagrant config",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"custom_parallels_config": schema.StringAttribute{
 				MarkdownDescription: "Custom Parallels config",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.StringRequiresReplaceUnlessImported(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -125,7 +130,9 @@ func GetResourceSchemaV1(ctx context.Context) schema.Schema {
 			"owner": schema.StringAttribute{
 				MarkdownDescription: "Virtual Machine owner",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
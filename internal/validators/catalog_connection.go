@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-parallels-desktop/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = catalogConnectionValidator{}
+
+// catalogConnectionValidator validates a catalog connection string can be parsed
+type catalogConnectionValidator struct{}
+
+// CatalogConnection returns a validator that checks the value is a valid catalog connection string,
+// reporting each of the problems found in it
+func CatalogConnection() validator.String {
+	return catalogConnectionValidator{}
+}
+
+func (v catalogConnectionValidator) Description(_ context.Context) string {
+	return "value must be a connection string in the format username:password@host[:port][?param=value&...]"
+}
+
+func (v catalogConnectionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v catalogConnectionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := common.ParseHostConnectionString(req.ConfigValue.ValueString()); err != nil {
+		// the connection string contains secrets so it is never added to the error
+		for _, problem := range strings.Split(err.Error(), "\n") {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Catalog Connection",
+				"The catalog connection string is not valid: "+problem,
+			)
+		}
+	}
+}
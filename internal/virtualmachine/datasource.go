@@ -81,6 +81,8 @@ func (d *VirtualMachinesDataSource) Read(ctx context.Context, req datasource.Rea
 		License:              d.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	retryAttempts := 10
@@ -3,6 +3,7 @@ package models
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 	"terraform-provider-parallels-desktop/internal/schemas/filter"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -10,6 +11,7 @@ import (
 // virtualMachinesDataSourceModel represents the data source schema for the virtual_machines data source.
 type VirtualMachinesDataSourceModelV2 struct {
 	Authenticator    *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel        *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
 	Host             types.String                  `tfsdk:"host"`
 	Orchestrator     types.String                  `tfsdk:"orchestrator"`
 	WaitForNetworkUp types.Bool                    `tfsdk:"wait_for_network_up"`
@@ -3,6 +3,7 @@ package schemas
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
 	"terraform-provider-parallels-desktop/internal/schemas/filter"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -14,6 +15,7 @@ var VirtualMachineDataSourceSchemaV2 = schema.Schema{
 	MarkdownDescription: "Virtual Machine Data Source",
 	Blocks: map[string]schema.Block{
 		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
 		filter.SchemaName:        filter.SchemaBlock,
 	},
 	Attributes: map[string]schema.Attribute{
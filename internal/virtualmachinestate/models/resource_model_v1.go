@@ -2,6 +2,7 @@ package models
 
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -9,6 +10,7 @@ import (
 // VirtualMachineStateResourceModel describes the resource data model.
 type VirtualMachineStateResourceModelV1 struct {
 	Authenticator *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel     *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
 	Orchestrator  types.String                  `tfsdk:"orchestrator"`
 	Host          types.String                  `tfsdk:"host"`
 	ID            types.String                  `tfsdk:"id"`
@@ -114,6 +114,8 @@ func (r *VirtualMachineStateResource) Create(ctx context.Context, req resource.C
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(apiCtx, hostConfig, data.ID.ValueString())
@@ -232,6 +234,8 @@ func (r *VirtualMachineStateResource) Read(ctx context.Context, req resource.Rea
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, diag := apiclient.GetVm(apiCtx, hostConfig, data.ID.ValueString())
@@ -311,6 +315,8 @@ func (r *VirtualMachineStateResource) Update(ctx context.Context, req resource.U
 		License:              r.provider.License.ValueString(),
 		Authorization:        data.Authenticator,
 		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
 	}
 
 	vm, vmDiag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
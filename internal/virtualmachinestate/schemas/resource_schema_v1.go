@@ -2,6 +2,7 @@ package schemas
 
 import (
 	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +16,7 @@ var VirtualMachineStateResourceSchemaV1 = schema.Schema{
 	MarkdownDescription: "Parallels Virtual Machine State Resource\n Use this to set a virtual machine to a desired state.",
 	Blocks: map[string]schema.Block{
 		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
 	},
 	Attributes: map[string]schema.Attribute{
 		"host": schema.StringAttribute{
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmdisk"
+	"terraform-provider-parallels-desktop/internal/schemas/vmnetwork"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VmResourceModelV0 describes the resource data model.
+type VmResourceModelV0 struct {
+	Authenticator   *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel       *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host            types.String                  `tfsdk:"host"`
+	Orchestrator    types.String                  `tfsdk:"orchestrator"`
+	ID              types.String                  `tfsdk:"id"`
+	Name            types.String                  `tfsdk:"name"`
+	Owner           types.String                  `tfsdk:"owner"`
+	Path            types.String                  `tfsdk:"path"`
+	OsType          types.String                  `tfsdk:"os_type"`
+	Distribution    types.String                  `tfsdk:"distribution"`
+	RestoreImage    types.String                  `tfsdk:"restore_image"`
+	IsoPath         types.String                  `tfsdk:"iso_path"`
+	BootOrder       []types.String                `tfsdk:"boot_order"`
+	GuestOs         types.String                  `tfsdk:"guest_os"`
+	Specs           *vmspecs.VmSpecs              `tfsdk:"specs"`
+	Config          *vmconfig.VmConfig            `tfsdk:"config"`
+	PrlCtl          []*prlctl.PrlCtlCmd           `tfsdk:"prlctl"`
+	Disks           []*vmdisk.VmDisk              `tfsdk:"disk"`
+	NetworkAdapters []*vmnetwork.VmNetworkAdapter `tfsdk:"network_adapter"`
+	Timeouts        timeouts.Value                `tfsdk:"timeouts"`
+	ForceChanges    types.Bool                    `tfsdk:"force_changes"`
+	KeepRunning     types.Bool                    `tfsdk:"keep_running"`
+	KeepAfterError  types.Bool                    `tfsdk:"keep_after_error"`
+}
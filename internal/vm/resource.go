@@ -0,0 +1,620 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+	resource_models "terraform-provider-parallels-desktop/internal/vm/models"
+	"terraform-provider-parallels-desktop/internal/vm/schemas"
+	"terraform-provider-parallels-desktop/internal/vmplan"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &VmResource{}
+	_ resource.ResourceWithModifyPlan = &VmResource{}
+)
+
+func NewVmResource() resource.Resource {
+	return &VmResource{}
+}
+
+// VmResource defines the resource implementation.
+type VmResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *VmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (r *VmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.GetVmSchemaV0(ctx)
+}
+
+func (r *VmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *VmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.VmResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventVm, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	if !isOrchestrator {
+		// before creating, if we have enough data we will be checking if we have enough resources
+		// in the current host if it is not an orchestrator, in that case it will be the orchestrator
+		// job to check if we have enough resources
+		if data.Specs != nil {
+			if diags := common.CheckIfEnoughSpecs(ctx, hostConfig, data.Specs, ""); diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+		}
+	}
+
+	// Checking if the name is already in use
+	existingVms, existingVmDiag := apiclient.GetVms(ctx, hostConfig, "name", data.Name.ValueString())
+	if existingVmDiag.HasError() {
+		resp.Diagnostics.Append(existingVmDiag...)
+		return
+	}
+
+	if len(existingVms) > 0 {
+		resp.Diagnostics.AddError("Name already in use", "A VM with the name "+data.Name.ValueString()+" already exists in the host")
+		return
+	}
+
+	osType := data.OsType.ValueString()
+	if data.RestoreImage.ValueString() != "" && osType == "" {
+		osType = "macos"
+	}
+
+	createMachineRequest := apimodels.CreateVmRequest{
+		Name:  data.Name.ValueString(),
+		Owner: data.Owner.ValueString(),
+		NewVm: &apimodels.CreateNewVmRequest{
+			Name:         data.Name.ValueString(),
+			Owner:        data.Owner.ValueString(),
+			OsType:       osType,
+			Distribution: data.Distribution.ValueString(),
+			RestoreImage: data.RestoreImage.ValueString(),
+			Path:         data.Path.ValueString(),
+		},
+	}
+
+	createVmResponse, createVmDiag := apiclient.CreateVm(ctx, hostConfig, createMachineRequest)
+	if createVmDiag.HasError() {
+		resp.Diagnostics.Append(createVmDiag...)
+		return
+	}
+
+	data.ID = types.StringValue(createVmResponse.ID)
+	tflog.Info(ctx, "Created vm "+data.Name.ValueString()+" with id "+data.ID.ValueString())
+
+	createdVm, getVmDiag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
+	if getVmDiag.HasError() {
+		resp.Diagnostics.Append(getVmDiag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+	if createdVm == nil {
+		resp.Diagnostics.AddError("VM not found", "There was an issue creating the VM, we could not find it in the host")
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	hostConfig.HostId = createdVm.HostId
+
+	// the machine needs to be stopped while we configure its hardware
+	stoppedVm, stoppedVmDiag := common.EnsureMachineStopped(ctx, hostConfig, createdVm)
+	if stoppedVmDiag.HasError() {
+		resp.Diagnostics.Append(stoppedVmDiag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	// Applying the Specs block
+	if diag := common.SpecsBlockOnCreate(ctx, hostConfig, stoppedVm, data.Specs); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	// Adding any extra disks
+	if diag := common.DisksBlockOnCreate(ctx, hostConfig, stoppedVm, data.Disks); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	// Configuring the network adapters
+	if diag := common.NetworkAdaptersBlockOnCreate(ctx, hostConfig, stoppedVm, data.NetworkAdapters); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	// Configuring the machine if there is any configuration
+	if diag := common.VmConfigBlockOnCreate(ctx, hostConfig, stoppedVm, data.Config); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	// Attaching the installation media
+	if data.IsoPath.ValueString() != "" {
+		if diag := apiclient.SetMachineInstallMedia(ctx, hostConfig, stoppedVm, data.IsoPath.ValueString()); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			r.removeAfterError(ctx, hostConfig, &data)
+			return
+		}
+	}
+
+	if len(data.BootOrder) > 0 {
+		if diag := apiclient.SetMachineBootOrder(ctx, hostConfig, stoppedVm, bootOrderValues(data.BootOrder)); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			r.removeAfterError(ctx, hostConfig, &data)
+			return
+		}
+	}
+
+	// Applying any prlctl commands
+	if diag := common.PrlCtlBlockOnCreate(ctx, hostConfig, stoppedVm, data.PrlCtl); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+
+	if data.KeepRunning.ValueBool() {
+		if _, diag := common.EnsureMachineRunning(ctx, hostConfig, stoppedVm); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			r.removeAfterError(ctx, hostConfig, &data)
+			return
+		}
+	}
+
+	data.GuestOs = types.StringValue(stoppedVm.OS)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		r.removeAfterError(ctx, hostConfig, &data)
+		return
+	}
+}
+
+func (r *VmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.VmResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventVm, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if vm == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(vm.Name)
+	data.GuestOs = types.StringValue(vm.OS)
+
+	// picking up any change done to the machine outside terraform
+	if data.Specs != nil {
+		data.Specs.RefreshFromVirtualMachine(vm)
+	}
+	if data.Config != nil {
+		data.Config.RefreshFromVirtualMachine(vm)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.VmResourceModelV0
+	var currentData resource_models.VmResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventVm, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, getVmDiag := apiclient.GetVm(ctx, hostConfig, currentData.ID.ValueString())
+	if getVmDiag.HasError() {
+		resp.Diagnostics.Append(getVmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	hostConfig.HostId = vm.HostId
+	currentState := vm.State
+	needsRestart := false
+
+	nameChanges := apimodels.NewVmConfigRequest(vm.User)
+	if vm.Name != data.Name.ValueString() {
+		op := apimodels.NewVmConfigRequestOperation(nameChanges)
+		op.WithGroup("machine")
+		op.WithOperation("rename")
+		op.WithValue(data.Name.ValueString())
+		op.Append()
+	}
+
+	configChanges := common.VmConfigBlockHasChanges(ctx, hostConfig, vm, data.Config, currentData.Config)
+	specsChanges := common.SpecsBlockHasChanges(ctx, hostConfig, vm, data.Specs, currentData.Specs)
+	prlctlChanges := common.PrlCtlBlockHasChanges(ctx, hostConfig, vm, data.PrlCtl, currentData.PrlCtl)
+	networkChanges := common.NetworkAdaptersBlockHasChanges(ctx, data.NetworkAdapters, currentData.NetworkAdapters)
+	bootOrderChanges := !bootOrderEquals(data.BootOrder, currentData.BootOrder)
+	requireShutdown := specsChanges || configChanges || prlctlChanges || networkChanges || bootOrderChanges || nameChanges.HasChanges()
+
+	if requireShutdown && vm.State != "stopped" {
+		if data.ForceChanges.ValueBool() {
+			if newVm, stopDiag := common.EnsureMachineStopped(ctx, hostConfig, vm); stopDiag.HasError() {
+				resp.Diagnostics.Append(stopDiag...)
+				return
+			} else {
+				vm = newVm
+			}
+
+			needsRestart = true
+		} else {
+			resp.Diagnostics.AddError("vm must be stopped before updating", "Virtual Machine "+vm.Name+" must be stopped before updating, currently "+vm.State)
+			return
+		}
+	}
+
+	// Changing the name of the machine
+	if nameChanges.HasChanges() {
+		if _, nameChangeDiag := apiclient.ConfigureMachine(ctx, hostConfig, vm.ID, nameChanges); nameChangeDiag.HasError() {
+			resp.Diagnostics.Append(nameChangeDiag...)
+			return
+		}
+	}
+
+	if specsChanges {
+		if diag := common.SpecsBlockOnUpdate(ctx, hostConfig, vm, data.Specs, currentData.Specs); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if networkChanges {
+		if diag := common.NetworkAdaptersBlockOnUpdate(ctx, hostConfig, vm, data.NetworkAdapters, currentData.NetworkAdapters); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if configChanges {
+		if diag := common.VmConfigBlockOnUpdate(ctx, hostConfig, vm, data.Config, currentData.Config); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if bootOrderChanges && len(data.BootOrder) > 0 {
+		if diag := apiclient.SetMachineBootOrder(ctx, hostConfig, vm, bootOrderValues(data.BootOrder)); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if prlctlChanges {
+		if diag := common.PrlCtlBlockOnUpdate(ctx, hostConfig, vm, data.PrlCtl); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	// the installation media can be swapped or detached while the machine is running
+	if data.IsoPath.ValueString() != currentData.IsoPath.ValueString() {
+		if diag := apiclient.SetMachineInstallMedia(ctx, hostConfig, vm, data.IsoPath.ValueString()); diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
+	if needsRestart || data.KeepRunning.ValueBool() || (vm.State == "stopped" && currentState == "running") {
+		if _, startDiag := common.EnsureMachineRunning(ctx, hostConfig, vm); startDiag.HasError() {
+			resp.Diagnostics.Append(startDiag...)
+			return
+		}
+	}
+
+	data.ID = currentData.ID
+	data.GuestOs = types.StringValue(vm.OS)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	options := vmplan.Options{}
+
+	// the network adapters and boot order also need the machine stopped to be changed
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var data resource_models.VmResourceModelV0
+		var currentData resource_models.VmResourceModelV0
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &currentData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		options.RequireShutdown = common.NetworkAdaptersBlockHasChanges(ctx, data.NetworkAdapters, currentData.NetworkAdapters) ||
+			!bootOrderEquals(data.BootOrder, currentData.BootOrder)
+	}
+
+	vmplan.ModifyPlan(ctx, r.provider, req, resp, options)
+}
+
+func (r *VmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.VmResourceModelV0
+
+	telemetrySvc := telemetry.Get(ctx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		ctx,
+		r.provider.License.String(),
+		telemetry.EventVm, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(ctx, telemetryEvent)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the default timeout
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, diag := apiclient.GetVm(ctx, hostConfig, data.ID.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	// Nothing to do, machine does not exist
+	if vm == nil {
+		return
+	}
+
+	hostConfig.HostId = vm.HostId
+
+	if removeDiag := common.EnsureMachineIsRemoved(ctx, hostConfig, vm.ID); removeDiag.HasError() {
+		resp.Diagnostics.Append(removeDiag...)
+		return
+	}
+}
+
+// removeAfterError removes a partially created machine unless we were asked to keep it
+func (r *VmResource) removeAfterError(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.VmResourceModelV0) {
+	if data.ID.ValueString() == "" || data.KeepAfterError.ValueBool() {
+		return
+	}
+
+	if diag := common.EnsureMachineIsRemoved(ctx, hostConfig, data.ID.ValueString()); diag.HasError() {
+		tflog.Error(ctx, "Error removing vm "+data.ID.ValueString()+" after a failed creation")
+	}
+}
+
+func bootOrderValues(bootOrder []types.String) []string {
+	devices := make([]string, 0, len(bootOrder))
+	for _, device := range bootOrder {
+		devices = append(devices, device.ValueString())
+	}
+
+	return devices
+}
+
+func bootOrderEquals(planBootOrder, stateBootOrder []types.String) bool {
+	if len(planBootOrder) != len(stateBootOrder) {
+		return false
+	}
+
+	for i := range planBootOrder {
+		if planBootOrder[i].ValueString() != stateBootOrder[i].ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,156 @@
+package schemas
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmdisk"
+	"terraform-provider-parallels-desktop/internal/schemas/vmnetwork"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetVmSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Parallels Desktop VM resource\n Use this to create a new virtual machine from an ISO image or a macOS restore image",
+		Blocks: map[string]schema.Block{
+			authenticator.SchemaName: authenticator.SchemaBlock,
+			sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+			vmspecs.SchemaName:       vmspecs.SchemaBlock,
+			vmconfig.SchemaName:      vmconfig.SchemaBlock,
+			prlctl.SchemaName:        prlctl.SchemaBlock,
+			vmdisk.SchemaName:        vmdisk.SchemaBlock,
+			vmnetwork.SchemaName:     vmnetwork.SchemaBlock,
+		},
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"force_changes": schema.BoolAttribute{
+				MarkdownDescription: "Force changes, this will force the VM to be stopped and started again",
+				Optional:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Host",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+			},
+			"orchestrator": schema.StringAttribute{
+				MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("orchestrator"),
+						path.MatchRoot("host"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Virtual Machine Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Virtual Machine name to create, this needs to be unique in the host",
+				Required:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Virtual Machine owner",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path where the virtual machine will be created, if empty the default Parallels Desktop folder is used",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Guest OS type, for example `linux`, `windows` or `macos`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRoot("os_type"),
+						path.MatchRoot("distribution"),
+						path.MatchRoot("restore_image"),
+					}...),
+				},
+			},
+			"distribution": schema.StringAttribute{
+				MarkdownDescription: "Guest OS distribution, for example `ubuntu`, `debian` or `win-11`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"restore_image": schema.StringAttribute{
+				MarkdownDescription: "Path in the host to a macOS IPSW restore image to install the virtual machine from, this is only supported on Apple Silicon hosts",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("iso_path"),
+						path.MatchRoot("distribution"),
+					}...),
+				},
+			},
+			"iso_path": schema.StringAttribute{
+				MarkdownDescription: "Path in the host to the installation ISO image, it will be attached to the virtual machine cdrom. Removing it detaches the installation media",
+				Optional:            true,
+			},
+			"boot_order": schema.ListAttribute{
+				MarkdownDescription: "Boot devices in order, for example `[\"cdrom0\", \"hdd0\", \"net0\"]`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"guest_os": schema.StringAttribute{
+				MarkdownDescription: "Virtual Machine OS type as reported by the host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keep_running": schema.BoolAttribute{
+				MarkdownDescription: "This will keep the VM running after the terraform apply, use it to boot the installation media after creation",
+				Optional:            true,
+			},
+			"keep_after_error": schema.BoolAttribute{
+				MarkdownDescription: "This will keep the VM if an error occurs during the creation",
+				Optional:            true,
+			},
+		},
+	}
+}
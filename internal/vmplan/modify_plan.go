@@ -0,0 +1,190 @@
+package vmplan
+
+import (
+	"context"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/prlctl"
+	"terraform-provider-parallels-desktop/internal/schemas/reverseproxy"
+	"terraform-provider-parallels-desktop/internal/schemas/sharedfolder"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/schemas/vmconfig"
+	"terraform-provider-parallels-desktop/internal/schemas/vmspecs"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Options are the resource specific values used by the machine plan checks
+type Options struct {
+	// Architecture of the machine if it is known, used when checking the host capacity
+	Architecture string
+	// RequireShutdown is set by resources with their own changes that need the machine stopped
+	RequireShutdown bool
+}
+
+// machineValues are the values shared by all the machine resources, they are read by path as
+// each resource has its own model
+type machineValues struct {
+	ID                types.String
+	Name              types.String
+	ForceChanges      types.Bool
+	Specs             *vmspecs.VmSpecs
+	Config            *vmconfig.VmConfig
+	PrlCtl            []*prlctl.PrlCtlCmd
+	SharedFolders     []*sharedfolder.SharedFolder
+	ReverseProxyHosts []*reverseproxy.ReverseProxyHost
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+type schemaTypes interface {
+	TypeAtPath(ctx context.Context, path path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// ModifyPlan runs the plan checks shared by the machine resources, it checks the reverse proxy
+// conflicts, if the host can run a new machine and if an update needs the machine stopped
+func ModifyPlan(ctx context.Context, provider *models.ParallelsProviderModel, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, options Options) {
+	// nothing to check if the resource is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || provider == nil {
+		return
+	}
+
+	hostConfig, diag := GetHostConfig(ctx, provider, req.Plan)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() || hostConfig == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(Check(ctx, *hostConfig, req, options)...)
+}
+
+// GetHostConfig returns the host config of the planned machine, it is nil if the host is only
+// known once other resources are created
+func GetHostConfig(ctx context.Context, provider *models.ParallelsProviderModel, plan tfsdk.Plan) (*apiclient.HostConfig, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	var host, orchestrator types.String
+	var auth *authenticator.Authentication
+	var sshTunnel *sshtunnel.SshTunnel
+	diagnostics.Append(plan.GetAttribute(ctx, path.Root("host"), &host)...)
+	diagnostics.Append(plan.GetAttribute(ctx, path.Root("orchestrator"), &orchestrator)...)
+	diagnostics.Append(plan.GetAttribute(ctx, path.Root(authenticator.SchemaName), &auth)...)
+	diagnostics.Append(plan.GetAttribute(ctx, path.Root(sshtunnel.SchemaName), &sshTunnel)...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	// the host might only be known once other resources are created
+	if host.IsUnknown() || orchestrator.IsUnknown() {
+		return nil, diagnostics
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	hostConfig := apiclient.HostConfig{
+		Host:                 host.ValueString(),
+		License:              provider.License.ValueString(),
+		Authorization:        auth,
+		DisableTlsValidation: provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            provider.ApiPrefix.ValueString(),
+		SshTunnel:            sshTunnel.GetConfig(),
+	}
+	if orchestrator.ValueString() != "" {
+		hostConfig.IsOrchestrator = true
+		hostConfig.Host = orchestrator.ValueString()
+	}
+
+	if hostConfig.Host == "" {
+		return nil, diagnostics
+	}
+
+	return &hostConfig, diagnostics
+}
+
+// Check runs the machine plan checks against the given host
+func Check(ctx context.Context, hostConfig apiclient.HostConfig, req resource.ModifyPlanRequest, options Options) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+
+	planned, valuesDiag := getMachineValues(ctx, req.Plan.Schema, req.Plan)
+	diagnostics.Append(valuesDiag...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	var current *machineValues
+	if !req.State.Raw.IsNull() {
+		current, valuesDiag = getMachineValues(ctx, req.State.Schema, req.State)
+		diagnostics.Append(valuesDiag...)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
+	// checking the reverse proxy hosts do not conflict with the ones already configured in the host
+	if len(planned.ReverseProxyHosts) > 0 {
+		var currentHosts []*reverseproxy.ReverseProxyHost
+		owner := ""
+		if current != nil {
+			currentHosts = current.ReverseProxyHosts
+			owner = current.ID.ValueString()
+		}
+		diagnostics.Append(reverseproxy.CheckConflicts(ctx, hostConfig, owner, reverseproxy.CopyReverseProxyHosts(planned.ReverseProxyHosts), reverseproxy.CopyReverseProxyHosts(currentHosts), reverseproxy.HostPath)...)
+		if diagnostics.HasError() {
+			return diagnostics
+		}
+	}
+
+	// this is a new machine, checking if the host can run it
+	if current == nil {
+		if planned.Specs != nil && !planned.Specs.CpuCount.IsUnknown() && !planned.Specs.MemorySize.IsUnknown() {
+			diagnostics.Append(common.CheckIfEnoughSpecs(ctx, hostConfig, planned.Specs, options.Architecture)...)
+		}
+		return diagnostics
+	}
+
+	requireShutdown := options.RequireShutdown ||
+		planned.Name.ValueString() != current.Name.ValueString() ||
+		common.SpecsBlockHasChanges(ctx, hostConfig, nil, planned.Specs, current.Specs) ||
+		common.VmConfigBlockHasChanges(ctx, hostConfig, nil, planned.Config, current.Config) ||
+		common.PrlCtlBlockHasChanges(ctx, hostConfig, nil, planned.PrlCtl, current.PrlCtl) ||
+		common.SharedFoldersBlockHasChanges(ctx, planned.SharedFolders, current.SharedFolders)
+
+	diagnostics.Append(common.CheckVmUpdatePlan(ctx, hostConfig, current.ID.ValueString(), requireShutdown, planned.ForceChanges.ValueBool(), planned.Specs, current.Specs, options.Architecture)...)
+	return diagnostics
+}
+
+// getMachineValues reads the shared machine values from the plan or state, the shared folders and
+// reverse proxy hosts are only read if the resource supports them
+func getMachineValues(ctx context.Context, schema schemaTypes, source attributeGetter) (*machineValues, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	values := machineValues{}
+
+	diagnostics.Append(source.GetAttribute(ctx, path.Root("id"), &values.ID)...)
+	diagnostics.Append(source.GetAttribute(ctx, path.Root("name"), &values.Name)...)
+	diagnostics.Append(source.GetAttribute(ctx, path.Root("force_changes"), &values.ForceChanges)...)
+	diagnostics.Append(source.GetAttribute(ctx, path.Root(vmspecs.SchemaName), &values.Specs)...)
+	diagnostics.Append(source.GetAttribute(ctx, path.Root(vmconfig.SchemaName), &values.Config)...)
+	diagnostics.Append(source.GetAttribute(ctx, path.Root(prlctl.SchemaName), &values.PrlCtl)...)
+	if hasPath(ctx, schema, sharedfolder.SchemaName) {
+		diagnostics.Append(source.GetAttribute(ctx, path.Root(sharedfolder.SchemaName), &values.SharedFolders)...)
+	}
+	if hasPath(ctx, schema, reverseproxy.SchemaName) {
+		diagnostics.Append(source.GetAttribute(ctx, path.Root(reverseproxy.SchemaName), &values.ReverseProxyHosts)...)
+	}
+
+	return &values, diagnostics
+}
+
+func hasPath(ctx context.Context, schema schemaTypes, name string) bool {
+	_, diag := schema.TypeAtPath(ctx, path.Root(name))
+	return !diag.HasError()
+}
@@ -0,0 +1,120 @@
+package vmsnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/models"
+	data_models "terraform-provider-parallels-desktop/internal/vmsnapshot/models"
+	"terraform-provider-parallels-desktop/internal/vmsnapshot/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &VmSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &VmSnapshotsDataSource{}
+)
+
+func NewVmSnapshotsDataSource() datasource.DataSource {
+	return &VmSnapshotsDataSource{}
+}
+
+type VmSnapshotsDataSource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (d *VmSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.provider = data
+}
+
+func (d *VmSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_snapshots"
+}
+
+func (d *VmSnapshotsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.VmSnapshotsDataSourceSchemaV0
+}
+
+func (d *VmSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_models.VmSnapshotsDataSourceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              d.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: d.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            d.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	snapshots, diag := apiclient.GetVmSnapshots(ctx, hostConfig, data.VmId.ValueString())
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	if snapshots == nil {
+		resp.Diagnostics.AddError("VM not found", "Could not find a VM with ID "+data.VmId.ValueString()+" in the host")
+		return
+	}
+
+	data.CurrentSnapshotId = types.StringValue("")
+	data.Snapshots = make([]data_models.VmSnapshotModelV0, 0)
+	for _, snapshot := range snapshots.Snapshots {
+		data.Snapshots = append(data.Snapshots, data_models.VmSnapshotModelV0{
+			ID:          types.StringValue(snapshot.ID),
+			Name:        types.StringValue(snapshot.Name),
+			Description: types.StringValue(snapshot.Description),
+			Date:        types.StringValue(snapshot.Date),
+			State:       types.StringValue(snapshot.State),
+			Current:     types.BoolValue(snapshot.Current),
+			ParentId:    types.StringValue(snapshot.Parent),
+		})
+
+		if snapshot.Current {
+			data.CurrentSnapshotId = types.StringValue(snapshot.ID)
+		}
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VmSnapshotsDataSourceModelV0 represents the data source schema for the vm_snapshots data source.
+type VmSnapshotsDataSourceModelV0 struct {
+	Authenticator     *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel         *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host              types.String                  `tfsdk:"host"`
+	Orchestrator      types.String                  `tfsdk:"orchestrator"`
+	VmId              types.String                  `tfsdk:"vm_id"`
+	CurrentSnapshotId types.String                  `tfsdk:"current_snapshot_id"`
+	Snapshots         []VmSnapshotModelV0           `tfsdk:"snapshots"`
+}
+
+// VmSnapshotModelV0 represents a snapshot in the virtual machine snapshot tree.
+type VmSnapshotModelV0 struct {
+	ID          types.String `tfsdk:"id"`          // The unique identifier of the snapshot.
+	Name        types.String `tfsdk:"name"`        // The name of the snapshot.
+	Description types.String `tfsdk:"description"` // The description of the snapshot.
+	Date        types.String `tfsdk:"date"`        // The date the snapshot was taken.
+	State       types.String `tfsdk:"state"`       // The state of the virtual machine when the snapshot was taken.
+	Current     types.Bool   `tfsdk:"current"`     // If the virtual machine is currently on this snapshot.
+	ParentId    types.String `tfsdk:"parent_id"`   // The unique identifier of the parent snapshot.
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VmSnapshotResourceModelV0 describes the resource data model.
+type VmSnapshotResourceModelV0 struct {
+	Authenticator  *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel      *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host           types.String                  `tfsdk:"host"`
+	Orchestrator   types.String                  `tfsdk:"orchestrator"`
+	VmId           types.String                  `tfsdk:"vm_id"`
+	ID             types.String                  `tfsdk:"id"`
+	Name           types.String                  `tfsdk:"name"`
+	Description    types.String                  `tfsdk:"description"`
+	RevertOnCreate types.Bool                    `tfsdk:"revert_on_create"`
+	Revert         types.String                  `tfsdk:"revert"`
+	DeleteChildren types.Bool                    `tfsdk:"delete_children"`
+	ParentId       types.String                  `tfsdk:"parent_id"`
+	Date           types.String                  `tfsdk:"date"`
+	State          types.String                  `tfsdk:"state"`
+}
@@ -0,0 +1,417 @@
+package vmsnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+	resource_models "terraform-provider-parallels-desktop/internal/vmsnapshot/models"
+	"terraform-provider-parallels-desktop/internal/vmsnapshot/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &VmSnapshotResource{}
+)
+
+func NewVmSnapshotResource() resource.Resource {
+	return &VmSnapshotResource{}
+}
+
+// VmSnapshotResource defines the resource implementation.
+type VmSnapshotResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *VmSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_snapshot"
+}
+
+func (r *VmSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.VmSnapshotResourceSchemaV0
+}
+
+func (r *VmSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *VmSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.VmSnapshotResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 30 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmSnapshot, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, data.VmId.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.Diagnostics.AddError("VM not found", "Could not find a VM with ID "+data.VmId.ValueString()+" in the host")
+		return
+	}
+
+	existingSnapshots, snapshotsDiag := apiclient.GetVmSnapshots(apiCtx, hostConfig, vm.ID)
+	if snapshotsDiag.HasError() {
+		resp.Diagnostics.Append(snapshotsDiag...)
+		return
+	}
+
+	var snapshot *apimodels.VmSnapshot
+	if existingSnapshots != nil {
+		snapshot = existingSnapshots.GetByName(data.Name.ValueString())
+	}
+	if snapshot != nil {
+		if !data.RevertOnCreate.ValueBool() {
+			resp.Diagnostics.AddError("Snapshot already exists", "The snapshot "+data.Name.ValueString()+" already exists in the VM "+vm.Name)
+			return
+		}
+
+		tflog.Info(apiCtx, "Snapshot "+data.Name.ValueString()+" already exists, reverting the VM "+vm.Name+" to it")
+		if revertDiag := apiclient.RevertVmSnapshot(apiCtx, hostConfig, vm, snapshot.ID); revertDiag.HasError() {
+			resp.Diagnostics.Append(revertDiag...)
+			return
+		}
+	} else {
+		if createDiag := apiclient.CreateVmSnapshot(apiCtx, hostConfig, vm, data.Name.ValueString(), data.Description.ValueString()); createDiag.HasError() {
+			resp.Diagnostics.Append(createDiag...)
+			return
+		}
+
+		// The snapshot list can take a moment to reflect the new snapshot
+		for range 10 {
+			snapshots, refreshDiag := apiclient.GetVmSnapshots(apiCtx, hostConfig, vm.ID)
+			if !refreshDiag.HasError() && snapshots != nil {
+				snapshot = snapshots.GetByName(data.Name.ValueString())
+				if snapshot != nil {
+					break
+				}
+			}
+			time.Sleep(2 * time.Second)
+		}
+
+		if snapshot == nil {
+			resp.Diagnostics.AddError("Snapshot not found", "Could not find the created snapshot "+data.Name.ValueString()+" in the VM "+vm.Name)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(snapshot.ID)
+	data.ParentId = types.StringValue(snapshot.Parent)
+	data.Date = types.StringValue(snapshot.Date)
+	data.State = types.StringValue(snapshot.State)
+
+	tflog.Info(apiCtx, "Created snapshot "+data.ID.ValueString()+" for vm "+vm.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.VmSnapshotResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 10 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmSnapshot, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, data.VmId.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	snapshots, snapshotsDiag := apiclient.GetVmSnapshots(apiCtx, hostConfig, vm.ID)
+	if snapshotsDiag.HasError() {
+		resp.Diagnostics.Append(snapshotsDiag...)
+		return
+	}
+	if snapshots == nil {
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	snapshot := snapshots.GetById(data.ID.ValueString())
+	if snapshot == nil {
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	data.ParentId = types.StringValue(snapshot.Parent)
+	data.Date = types.StringValue(snapshot.Date)
+	data.State = types.StringValue(snapshot.State)
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.VmSnapshotResourceModelV0
+	var currentData resource_models.VmSnapshotResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 30 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmSnapshot, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &currentData)...)
+	resp.Diagnostics.Append(req.Plan.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, currentData.VmId.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	data.ID = currentData.ID
+	data.ParentId = currentData.ParentId
+	data.Date = currentData.Date
+	data.State = currentData.State
+
+	// The revert attribute is a trigger, any change to it will revert the machine
+	if !data.Revert.IsNull() && data.Revert.ValueString() != currentData.Revert.ValueString() {
+		if revertDiag := apiclient.RevertVmSnapshot(apiCtx, hostConfig, vm, data.ID.ValueString()); revertDiag.HasError() {
+			resp.Diagnostics.Append(revertDiag...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.VmSnapshotResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 30 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmSnapshot, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, data.VmId.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+
+	// Nothing to do, machine does not exist so the snapshot is gone as well
+	if vm == nil {
+		return
+	}
+
+	snapshots, snapshotsDiag := apiclient.GetVmSnapshots(apiCtx, hostConfig, vm.ID)
+	if snapshotsDiag.HasError() {
+		resp.Diagnostics.Append(snapshotsDiag...)
+		return
+	}
+
+	// Nothing to do, the snapshot was already removed
+	if snapshots == nil || snapshots.GetById(data.ID.ValueString()) == nil {
+		return
+	}
+
+	if deleteDiag := apiclient.DeleteVmSnapshot(apiCtx, hostConfig, vm, data.ID.ValueString(), data.DeleteChildren.ValueBool()); deleteDiag.HasError() {
+		resp.Diagnostics.Append(deleteDiag...)
+		return
+	}
+}
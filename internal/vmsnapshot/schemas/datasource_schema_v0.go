@@ -0,0 +1,84 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var VmSnapshotsDataSourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Virtual Machine Snapshots Data Source",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"vm_id": schema.StringAttribute{
+			MarkdownDescription: "Virtual Machine Id",
+			Required:            true,
+		},
+		"current_snapshot_id": schema.StringAttribute{
+			MarkdownDescription: "The snapshot Id the virtual machine is currently on",
+			Computed:            true,
+		},
+		"snapshots": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the snapshot",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the snapshot",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the snapshot",
+						Computed:            true,
+					},
+					"date": schema.StringAttribute{
+						MarkdownDescription: "The date the snapshot was taken",
+						Computed:            true,
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "The state of the virtual machine when the snapshot was taken",
+						Computed:            true,
+					},
+					"current": schema.BoolAttribute{
+						MarkdownDescription: "If the virtual machine is currently on this snapshot",
+						Computed:            true,
+					},
+					"parent_id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the parent snapshot, empty for the root of the tree",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
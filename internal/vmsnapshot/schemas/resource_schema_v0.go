@@ -0,0 +1,101 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var VmSnapshotResourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Parallels Virtual Machine Snapshot Resource\n Use this to create a snapshot of a virtual machine and revert the machine to it.",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"vm_id": schema.StringAttribute{
+			MarkdownDescription: "Virtual Machine Id to snapshot",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Snapshot Id",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Snapshot name",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Snapshot description",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"revert_on_create": schema.BoolAttribute{
+			MarkdownDescription: "If a snapshot with the same name already exists in the virtual machine it will be used instead of creating a new one and the virtual machine will be reverted to it",
+			Optional:            true,
+		},
+		"revert": schema.StringAttribute{
+			MarkdownDescription: "Revert trigger, any change to this value will revert the virtual machine to this snapshot",
+			Optional:            true,
+		},
+		"delete_children": schema.BoolAttribute{
+			MarkdownDescription: "Delete the snapshot children when the snapshot is destroyed",
+			Optional:            true,
+		},
+		"parent_id": schema.StringAttribute{
+			MarkdownDescription: "Parent snapshot Id",
+			Computed:            true,
+		},
+		"date": schema.StringAttribute{
+			MarkdownDescription: "Snapshot creation date",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Virtual Machine state when the snapshot was taken",
+			Computed:            true,
+		},
+	},
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VmTemplateResourceModelV0 describes the resource data model.
+type VmTemplateResourceModelV0 struct {
+	Authenticator     *authenticator.Authentication `tfsdk:"authenticator"`
+	SshTunnel         *sshtunnel.SshTunnel          `tfsdk:"ssh_tunnel"`
+	Host              types.String                  `tfsdk:"host"`
+	Orchestrator      types.String                  `tfsdk:"orchestrator"`
+	ID                types.String                  `tfsdk:"id"`
+	OsType            types.String                  `tfsdk:"os_type"`
+	VmId              types.String                  `tfsdk:"vm_id"`
+	CatalogId         types.String                  `tfsdk:"catalog_id"`
+	Version           types.String                  `tfsdk:"version"`
+	Architecture      types.String                  `tfsdk:"architecture"`
+	CatalogConnection types.String                  `tfsdk:"catalog_connection"`
+	Path              types.String                  `tfsdk:"path"`
+	Owner             types.String                  `tfsdk:"owner"`
+	Name              types.String                  `tfsdk:"name"`
+	DeleteOnDestroy   types.Bool                    `tfsdk:"delete_on_destroy"`
+}
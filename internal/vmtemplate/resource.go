@@ -0,0 +1,529 @@
+package vmtemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-parallels-desktop/internal/apiclient"
+	"terraform-provider-parallels-desktop/internal/apiclient/apimodels"
+	"terraform-provider-parallels-desktop/internal/common"
+	"terraform-provider-parallels-desktop/internal/models"
+	"terraform-provider-parallels-desktop/internal/telemetry"
+	resource_models "terraform-provider-parallels-desktop/internal/vmtemplate/models"
+	"terraform-provider-parallels-desktop/internal/vmtemplate/schemas"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource = &VmTemplateResource{}
+)
+
+func NewVmTemplateResource() resource.Resource {
+	return &VmTemplateResource{}
+}
+
+// VmTemplateResource defines the resource implementation.
+type VmTemplateResource struct {
+	provider *models.ParallelsProviderModel
+}
+
+func (r *VmTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_template"
+}
+
+func (r *VmTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.VmTemplateResourceSchemaV0
+}
+
+func (r *VmTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*models.ParallelsProviderModel)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *models.ParallelsProviderModel, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.provider = data
+}
+
+func (r *VmTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_models.VmTemplateResourceModelV0
+
+	// Setting the default timeout, catalog imports can take a while
+	ctxTimeout := 60 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmTemplate, telemetry.ModeCreate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.Plan.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	var vm *apimodels.VirtualMachine
+	var vmDiag diag.Diagnostics
+	if data.CatalogId.ValueString() != "" {
+		vm, vmDiag = importCatalogTemplate(apiCtx, hostConfig, &data)
+	} else {
+		vm, vmDiag = apiclient.GetVm(apiCtx, hostConfig, data.VmId.ValueString())
+		if !vmDiag.HasError() && vm == nil {
+			vmDiag.AddError("VM not found", "Could not find a VM with ID "+data.VmId.ValueString()+" in the host")
+		}
+	}
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+
+	hostConfig.HostId = vm.HostId
+
+	// a machine imported from the catalog was created by us, it needs to be removed if the
+	// conversion fails or it would be left behind in the host without a state
+	removeImportedVm := func() {
+		if data.CatalogId.ValueString() == "" {
+			return
+		}
+		if ensureRemoveDiag := common.EnsureMachineIsRemoved(apiCtx, hostConfig, vm.ID); ensureRemoveDiag.HasError() {
+			resp.Diagnostics.Append(ensureRemoveDiag...)
+		}
+	}
+
+	if !vm.IsTemplate() {
+		// a template needs to be stopped before the conversion
+		stoppedVm, stopDiag := common.EnsureMachineStopped(apiCtx, hostConfig, vm)
+		if stopDiag.HasError() {
+			resp.Diagnostics.Append(stopDiag...)
+			removeImportedVm()
+			return
+		}
+
+		if renameDiag := renameTemplate(apiCtx, hostConfig, stoppedVm, data.Name.ValueString()); renameDiag.HasError() {
+			resp.Diagnostics.Append(renameDiag...)
+			removeImportedVm()
+			return
+		}
+
+		if templateDiag := apiclient.SetMachineTemplate(apiCtx, hostConfig, stoppedVm, true); templateDiag.HasError() {
+			resp.Diagnostics.Append(templateDiag...)
+			removeImportedVm()
+			return
+		}
+	} else {
+		tflog.Info(apiCtx, "Machine "+vm.Name+" is already a template")
+		if renameDiag := renameTemplate(apiCtx, hostConfig, vm, data.Name.ValueString()); renameDiag.HasError() {
+			resp.Diagnostics.Append(renameDiag...)
+			removeImportedVm()
+			return
+		}
+	}
+
+	templateVm, refreshDiag := apiclient.GetVm(apiCtx, hostConfig, vm.ID)
+	if refreshDiag.HasError() {
+		resp.Diagnostics.Append(refreshDiag...)
+		removeImportedVm()
+		return
+	}
+	if templateVm == nil {
+		resp.Diagnostics.AddError("Template not found", "Could not find the template "+vm.Name+" in the host after the conversion")
+		removeImportedVm()
+		return
+	}
+
+	data.ID = types.StringValue(templateVm.ID)
+	data.Name = types.StringValue(templateVm.Name)
+	data.OsType = types.StringValue(templateVm.OS)
+
+	tflog.Info(apiCtx, "Created template "+data.Name.ValueString()+" with id "+data.ID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_models.VmTemplateResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 10 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmTemplate, telemetry.ModeRead,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, data.ID.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	// the machine was converted back outside of terraform, so the template no longer exists
+	if !vm.IsTemplate() {
+		tflog.Warn(apiCtx, "Machine "+vm.Name+" is no longer a template, removing it from the state")
+		resp.State.RemoveResource(apiCtx)
+		return
+	}
+
+	data.Name = types.StringValue(vm.Name)
+	data.OsType = types.StringValue(vm.OS)
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_models.VmTemplateResourceModelV0
+	var currentData resource_models.VmTemplateResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 10 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmTemplate, telemetry.ModeUpdate,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &currentData)...)
+	resp.Diagnostics.Append(req.Plan.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, currentData.ID.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+	if vm == nil {
+		resp.Diagnostics.AddError("Template not found", "Could not find the template "+currentData.ID.ValueString()+" in the host")
+		return
+	}
+
+	hostConfig.HostId = vm.HostId
+
+	if renameDiag := renameTemplate(apiCtx, hostConfig, vm, data.Name.ValueString()); renameDiag.HasError() {
+		resp.Diagnostics.Append(renameDiag...)
+		return
+	}
+
+	data.ID = currentData.ID
+	data.OsType = currentData.OsType
+	if data.Name.IsUnknown() || data.Name.IsNull() {
+		data.Name = currentData.Name
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VmTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_models.VmTemplateResourceModelV0
+
+	// Setting the default timeout
+	ctxTimeout := 30 * time.Minute
+
+	apiCtx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	telemetrySvc := telemetry.Get(apiCtx)
+	telemetryEvent := telemetry.NewTelemetryItem(
+		apiCtx,
+		r.provider.License.String(),
+		telemetry.EventVmTemplate, telemetry.ModeDestroy,
+		nil,
+		nil,
+	)
+	telemetrySvc.TrackEvent(apiCtx, telemetryEvent)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(apiCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// selecting if this is a standalone host or an orchestrator
+	isOrchestrator := false
+	var host string
+	if data.Orchestrator.ValueString() != "" {
+		isOrchestrator = true
+		host = data.Orchestrator.ValueString()
+	} else {
+		host = data.Host.ValueString()
+	}
+
+	if host == "" {
+		resp.Diagnostics.AddError("host cannot be empty", "Host cannot be null")
+		return
+	}
+
+	hostConfig := apiclient.HostConfig{
+		Host:                 host,
+		IsOrchestrator:       isOrchestrator,
+		License:              r.provider.License.ValueString(),
+		Authorization:        data.Authenticator,
+		DisableTlsValidation: r.provider.DisableTlsValidation.ValueBool(),
+		ApiPrefix:            r.provider.ApiPrefix.ValueString(),
+		SshTunnel:            data.SshTunnel.GetConfig(),
+	}
+
+	vm, vmDiag := apiclient.GetVm(apiCtx, hostConfig, data.ID.ValueString())
+	if vmDiag.HasError() {
+		resp.Diagnostics.Append(vmDiag...)
+		return
+	}
+
+	// Nothing to do, template does not exist
+	if vm == nil {
+		return
+	}
+
+	hostConfig.HostId = vm.HostId
+
+	if data.CatalogId.ValueString() != "" || data.DeleteOnDestroy.ValueBool() {
+		if removeDiag := common.EnsureMachineIsRemoved(apiCtx, hostConfig, vm.ID); removeDiag.HasError() {
+			resp.Diagnostics.Append(removeDiag...)
+			return
+		}
+
+		tflog.Info(apiCtx, "Deleted template "+vm.Name)
+		return
+	}
+
+	if vm.IsTemplate() {
+		if templateDiag := apiclient.SetMachineTemplate(apiCtx, hostConfig, vm, false); templateDiag.HasError() {
+			resp.Diagnostics.Append(templateDiag...)
+			return
+		}
+	}
+
+	tflog.Info(apiCtx, "Converted template "+vm.Name+" back into a virtual machine")
+}
+
+// importCatalogTemplate pulls the catalog manifest into the host and returns the created machine
+func importCatalogTemplate(ctx context.Context, hostConfig apiclient.HostConfig, data *resource_models.VmTemplateResourceModelV0) (*apimodels.VirtualMachine, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+
+	catalogHostConfig, err := common.ParseHostConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		diagnostics.AddError("error parsing host connection string", err.Error())
+		return nil, diagnostics
+	}
+	catalogHostConfig.DisableTlsValidation = catalogHostConfig.DisableTlsValidation || hostConfig.DisableTlsValidation
+	catalogConnection, err := common.GetServiceConnectionString(data.CatalogConnection.ValueString())
+	if err != nil {
+		diagnostics.AddError("error parsing host connection string", err.Error())
+		return nil, diagnostics
+	}
+
+	catalogManifest, catalogManifestDiag := apiclient.GetCatalogManifest(ctx, *catalogHostConfig, data.CatalogId.ValueString(), data.Version.ValueString(), data.Architecture.ValueString())
+	if catalogManifestDiag.HasError() || catalogManifest == nil {
+		diagnostics.AddError("Catalog Not Found", fmt.Sprintf("Catalog %s was not found on %s", data.CatalogId.ValueString(), catalogHostConfig.Host))
+		return nil, diagnostics
+	}
+
+	existingVms, existingVmDiag := apiclient.GetVms(ctx, hostConfig, "name", data.Name.ValueString())
+	if existingVmDiag.HasError() {
+		diagnostics.Append(existingVmDiag...)
+		return nil, diagnostics
+	}
+	if len(existingVms) > 0 {
+		diagnostics.AddError("Name already in use", "A VM with the name "+data.Name.ValueString()+" already exists in the host")
+		return nil, diagnostics
+	}
+
+	version := catalogManifest.Version
+	architecture := catalogManifest.Architecture
+	if data.Version.ValueString() != "" {
+		version = data.Version.ValueString()
+	}
+	if data.Architecture.ValueString() != "" {
+		architecture = data.Architecture.ValueString()
+	}
+
+	createMachineRequest := apimodels.CreateVmRequest{
+		Name:         data.Name.ValueString(),
+		Architecture: architecture,
+		CatalogManifest: &apimodels.CreateCatalogManifestRequest{
+			MachineName:  data.Name.ValueString(),
+			CatalogId:    data.CatalogId.ValueString(),
+			Version:      version,
+			Architecture: architecture,
+			Connection:   catalogConnection,
+			Path:         data.Path.ValueString(),
+		},
+	}
+
+	if data.Owner.ValueString() != "" {
+		createMachineRequest.Owner = data.Owner.ValueString()
+	}
+
+	createVmResponse, createVmDiag := apiclient.CreateVm(ctx, hostConfig, createMachineRequest)
+	if createVmDiag.HasError() {
+		common.EnsureMachineIsRemoved(ctx, hostConfig, data.Name.ValueString())
+		diagnostics.Append(createVmDiag...)
+		return nil, diagnostics
+	}
+
+	createdVm, getVmDiag := apiclient.GetVm(ctx, hostConfig, createVmResponse.ID)
+	if getVmDiag.HasError() {
+		common.EnsureMachineIsRemoved(ctx, hostConfig, createVmResponse.ID)
+		diagnostics.Append(getVmDiag...)
+		return nil, diagnostics
+	}
+	if createdVm == nil {
+		common.EnsureMachineIsRemoved(ctx, hostConfig, createVmResponse.ID)
+		diagnostics.AddError("VM not found", "There was an issue importing the template, we could not find it in the host")
+		return nil, diagnostics
+	}
+
+	return createdVm, diagnostics
+}
+
+// renameTemplate renames the machine if the requested name is set and differs from the current one
+func renameTemplate(ctx context.Context, hostConfig apiclient.HostConfig, vm *apimodels.VirtualMachine, name string) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	if name == "" || vm.Name == name {
+		return diagnostics
+	}
+
+	nameChanges := apimodels.NewVmConfigRequest(vm.User)
+	op := apimodels.NewVmConfigRequestOperation(nameChanges)
+	op.WithGroup("machine")
+	op.WithOperation("rename")
+	op.WithValue(name)
+	op.Append()
+
+	if _, nameChangeDiag := apiclient.ConfigureMachine(ctx, hostConfig, vm.ID, nameChanges); nameChangeDiag.HasError() {
+		diagnostics.Append(nameChangeDiag...)
+		return diagnostics
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,145 @@
+package schemas
+
+import (
+	"terraform-provider-parallels-desktop/internal/schemas/authenticator"
+	"terraform-provider-parallels-desktop/internal/schemas/sshtunnel"
+	"terraform-provider-parallels-desktop/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var VmTemplateResourceSchemaV0 = schema.Schema{
+	MarkdownDescription: "Parallels Virtual Machine Template Resource\n Use this to convert a prepared virtual machine into a template or to import a template from a catalog, templates can then be used as the base of `parallels-desktop_clone_vm` resources.",
+	Blocks: map[string]schema.Block{
+		authenticator.SchemaName: authenticator.SchemaBlock,
+		sshtunnel.SchemaName:     sshtunnel.SchemaBlock,
+	},
+	Attributes: map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Host",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+		},
+		"orchestrator": schema.StringAttribute{
+			MarkdownDescription: "Parallels Desktop DevOps Orchestrator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.Expressions{
+					path.MatchRoot("orchestrator"),
+					path.MatchRoot("host"),
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Template Id",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"os_type": schema.StringAttribute{
+			MarkdownDescription: "Template OS type",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vm_id": schema.StringAttribute{
+			MarkdownDescription: "Id of the prepared Virtual Machine to convert into a template, the machine will be stopped before the conversion",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRoot("vm_id"),
+					path.MatchRoot("catalog_id"),
+				}...),
+			},
+		},
+		"catalog_id": schema.StringAttribute{
+			MarkdownDescription: "Catalog Id to import as a template",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRoot("vm_id"),
+					path.MatchRoot("catalog_id"),
+				}...),
+				stringvalidator.AlsoRequires(path.Expressions{
+					path.MatchRoot("name"),
+					path.MatchRoot("catalog_connection"),
+					path.MatchRoot("path"),
+				}...),
+			},
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Catalog version to import, if empty will import the 'latest' version",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "Catalog architecture to import",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"catalog_connection": schema.StringAttribute{
+			MarkdownDescription: "Parallels DevOps Catalog Connection",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validators.CatalogConnection(),
+			},
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "Path where the imported template will be stored",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "Template owner",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Template name, this needs to be unique in the host. When converting a virtual machine it defaults to the machine name and changing it renames the template",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"delete_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Delete the converted virtual machine when the template is destroyed, by default it is converted back into a regular virtual machine. Templates imported from a catalog are always deleted",
+			Optional:            true,
+		},
+	},
+}